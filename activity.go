@@ -1,12 +1,13 @@
 package backlog
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"strconv"
 )
 
-func getActivityList(get clientGet, spath string, options ...ActivityOption) ([]*Activity, error) {
+func getActivityList(ctx context.Context, get clientGet, spath string, options ...ActivityOption) ([]*Activity, error) {
 	params := newRequestParams()
 	for _, option := range options {
 		if err := option(params); err != nil {
@@ -14,7 +15,11 @@ func getActivityList(get clientGet, spath string, options ...ActivityOption) ([]
 		}
 	}
 
-	resp, err := get(spath, params)
+	return fetchActivityList(ctx, get, spath, params)
+}
+
+func fetchActivityList(ctx context.Context, get clientGet, spath string, params *requestParams) ([]*Activity, error) {
+	resp, err := get(ctx, spath, params)
 	if err != nil {
 		return nil, err
 	}
@@ -39,13 +44,37 @@ type ProjectActivityService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-project-recent-updates
 func (s *ProjectActivityService) List(target ProjectIDOrKeyGetter, options ...ActivityOption) ([]*Activity, error) {
+	return s.ListContext(context.Background(), target, options...)
+}
+
+// ListContext is like List but with the context.
+func (s *ProjectActivityService) ListContext(ctx context.Context, target ProjectIDOrKeyGetter, options ...ActivityOption) ([]*Activity, error) {
 	projectIDOrKey, err := target.getProjectIDOrKey()
 	if err != nil {
 		return nil, err
 	}
 
 	spath := "projects/" + projectIDOrKey + "/activities"
-	return getActivityList(s.method.Get, spath, options...)
+	return getActivityList(ctx, s.method.Get, spath, options...)
+}
+
+// Iterate returns an iterator of all activities in the project.
+// It fetches pages by maxId or minId while the iteration continues.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-project-recent-updates
+func (s *ProjectActivityService) Iterate(target ProjectIDOrKeyGetter, options ...ActivityOption) *ActivityIterator {
+	return s.IterateContext(context.Background(), target, options...)
+}
+
+// IterateContext is like Iterate but with the context.
+func (s *ProjectActivityService) IterateContext(ctx context.Context, target ProjectIDOrKeyGetter, options ...ActivityOption) *ActivityIterator {
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return &ActivityIterator{pager: &pager{err: err}}
+	}
+
+	spath := "projects/" + projectIDOrKey + "/activities"
+	return newActivityIterator(ctx, s.method.Get, spath, options)
 }
 
 // SpaceActivityService has methods for activitys in your space.
@@ -59,8 +88,27 @@ type SpaceActivityService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-recent-updates
 func (s *SpaceActivityService) List(options ...ActivityOption) ([]*Activity, error) {
+	return s.ListContext(context.Background(), options...)
+}
+
+// ListContext is like List but with the context.
+func (s *SpaceActivityService) ListContext(ctx context.Context, options ...ActivityOption) ([]*Activity, error) {
+	spath := "space/activities"
+	return getActivityList(ctx, s.method.Get, spath, options...)
+}
+
+// Iterate returns an iterator of all activities in your space.
+// It fetches pages by maxId or minId while the iteration continues.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-recent-updates
+func (s *SpaceActivityService) Iterate(options ...ActivityOption) *ActivityIterator {
+	return s.IterateContext(context.Background(), options...)
+}
+
+// IterateContext is like Iterate but with the context.
+func (s *SpaceActivityService) IterateContext(ctx context.Context, options ...ActivityOption) *ActivityIterator {
 	spath := "space/activities"
-	return getActivityList(s.method.Get, spath, options...)
+	return newActivityIterator(ctx, s.method.Get, spath, options)
 }
 
 // UserActivityService has methods for user activitys.
@@ -74,10 +122,33 @@ type UserActivityService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-user-recent-updates
 func (s *UserActivityService) List(userID int, options ...ActivityOption) ([]*Activity, error) {
+	return s.ListContext(context.Background(), userID, options...)
+}
+
+// ListContext is like List but with the context.
+func (s *UserActivityService) ListContext(ctx context.Context, userID int, options ...ActivityOption) ([]*Activity, error) {
 	if userID < 1 {
 		return nil, errors.New("userID must be greater than 1")
 	}
 
 	spath := "users/" + strconv.Itoa(userID) + "/activities"
-	return getActivityList(s.method.Get, spath, options...)
+	return getActivityList(ctx, s.method.Get, spath, options...)
+}
+
+// Iterate returns an iterator of all user activities.
+// It fetches pages by maxId or minId while the iteration continues.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-user-recent-updates
+func (s *UserActivityService) Iterate(userID int, options ...ActivityOption) *ActivityIterator {
+	return s.IterateContext(context.Background(), userID, options...)
+}
+
+// IterateContext is like Iterate but with the context.
+func (s *UserActivityService) IterateContext(ctx context.Context, userID int, options ...ActivityOption) *ActivityIterator {
+	if userID < 1 {
+		return &ActivityIterator{pager: &pager{err: errors.New("userID must be greater than 1")}}
+	}
+
+	spath := "users/" + strconv.Itoa(userID) + "/activities"
+	return newActivityIterator(ctx, s.method.Get, spath, options)
 }
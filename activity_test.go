@@ -1,6 +1,7 @@
 package backlog_test
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"os"
@@ -20,7 +21,7 @@ func TestProjectActivityService_List(t *testing.T) {
 	}
 	s := &backlog.ProjectActivityService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			return nil, errors.New("error")
 		},
@@ -32,7 +33,7 @@ func TestProjectActivityService_List_projectIDOrKeyIsEmpty(t *testing.T) {
 	projectKey := ""
 	s := &backlog.ProjectActivityService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			t.Error("s.method.Get must never be called")
 			return nil, errors.New("error")
 		},
@@ -49,7 +50,7 @@ func TestProjectActivityService_List_invaliedJson(t *testing.T) {
 
 	s := &backlog.ProjectActivityService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -70,7 +71,7 @@ func TestSpaceActivityService_List(t *testing.T) {
 	}
 	s := &backlog.SpaceActivityService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			return nil, errors.New("error")
 		},
@@ -87,7 +88,7 @@ func TestUserActivityService_List(t *testing.T) {
 	}
 	s := &backlog.UserActivityService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			return nil, errors.New("error")
 		},
@@ -99,7 +100,7 @@ func TestUserActivityService_List_invaliedID(t *testing.T) {
 	id := 0
 	s := &backlog.UserActivityService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			t.Error("s.method.Get must never be called")
 			return nil, errors.New("error")
 		},
@@ -261,7 +262,7 @@ func TestBaseActivityService_GetList(t *testing.T) {
 
 			s := &backlog.SpaceActivityService{}
 			s.ExportSetMethod(&backlog.ExportMethod{
-				Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+				Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 					v := *params.ExportURLValues()
 					assert.Equal(t, tc.want.activityTypeID, v["activityTypeId[]"])
 					assert.Equal(t, tc.want.minID, params.Get("minId"))
@@ -1,13 +1,18 @@
 package backlog
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"strconv"
 )
 
 // SpaceAttachmentService hs methods for attachment.
 type SpaceAttachmentService struct {
 	method *method
+
+	Option *UploadOptionService
 }
 
 // Uploade uploads a any file to the space.
@@ -16,8 +21,13 @@ type SpaceAttachmentService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/post-attachment-file
 func (s *SpaceAttachmentService) Uploade(fpath, fname string) (*Attachment, error) {
+	return s.UploadeContext(context.Background(), fpath, fname)
+}
+
+// UploadeContext is like Uploade but with the context.
+func (s *SpaceAttachmentService) UploadeContext(ctx context.Context, fpath, fname string) (*Attachment, error) {
 	spath := "space/attachment"
-	resp, err := s.method.Uploade(spath, fpath, fname)
+	resp, err := s.method.Uploade(ctx, spath, fpath, fname)
 	if err != nil {
 		return nil, err
 	}
@@ -31,8 +41,49 @@ func (s *SpaceAttachmentService) Uploade(fpath, fname string) (*Attachment, erro
 	return &v, nil
 }
 
-func listAttachments(get clientGet, spath string) ([]*Attachment, error) {
-	resp, err := get(spath, nil)
+// UploadReader uploads a file read from the reader to the space.
+// The file is streamed without buffering the whole content in memory,
+// so the request is never retried.
+//
+// File's name is must not empty.
+//
+// This method supports options returned by methods in "*Client.Space.Attachment.Option".
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/post-attachment-file
+func (s *SpaceAttachmentService) UploadReader(fname string, r io.Reader, options ...UploadOption) (*Attachment, error) {
+	return s.UploadReaderContext(context.Background(), fname, r, options...)
+}
+
+// UploadReaderContext is like UploadReader but with the context.
+func (s *SpaceAttachmentService) UploadReaderContext(ctx context.Context, fname string, r io.Reader, options ...UploadOption) (*Attachment, error) {
+	f := &uploadFile{
+		name:   fname,
+		reader: r,
+		size:   -1,
+	}
+	for _, option := range options {
+		if err := option(f); err != nil {
+			return nil, err
+		}
+	}
+
+	spath := "space/attachment"
+	resp, err := s.method.UploadReader(ctx, spath, f)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := Attachment{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+func listAttachments(ctx context.Context, get clientGet, spath string) ([]*Attachment, error) {
+	resp, err := get(ctx, spath, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -46,8 +97,8 @@ func listAttachments(get clientGet, spath string) ([]*Attachment, error) {
 	return v, nil
 }
 
-func removeAttachment(delete clientDelete, spath string) (*Attachment, error) {
-	resp, err := delete(spath, nil)
+func removeAttachment(ctx context.Context, delete clientDelete, spath string) (*Attachment, error) {
+	resp, err := delete(ctx, spath, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +112,19 @@ func removeAttachment(delete clientDelete, spath string) (*Attachment, error) {
 	return &v, nil
 }
 
+func downloadAttachment(ctx context.Context, download clientDownload, spath string, attachmentID int) (*File, error) {
+	if attachmentID < 1 {
+		return nil, fmt.Errorf("attachmentID must be 1 or more: %d", attachmentID)
+	}
+
+	resp, err := download(ctx, spath+"/"+strconv.Itoa(attachmentID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return newFile(resp), nil
+}
+
 // WikiAttachmentService hs methods for attachment file of wiki.
 type WikiAttachmentService struct {
 	method *method
@@ -70,12 +134,17 @@ type WikiAttachmentService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/attach-file-to-wiki
 func (s *WikiAttachmentService) Attach(wikiID int, attachmentIDs []int) ([]*Attachment, error) {
+	return s.AttachContext(context.Background(), wikiID, attachmentIDs)
+}
+
+// AttachContext is like Attach but with the context.
+func (s *WikiAttachmentService) AttachContext(ctx context.Context, wikiID int, attachmentIDs []int) ([]*Attachment, error) {
 	params := newRequestParams()
 	for _, id := range attachmentIDs {
 		params.Add("attachmentId[]", strconv.Itoa(id))
 	}
 	spath := "wikis/" + strconv.Itoa(wikiID) + "/attachments"
-	resp, err := s.method.Post(spath, params)
+	resp, err := s.method.Post(ctx, spath, params)
 	if err != nil {
 		return nil, err
 	}
@@ -93,16 +162,41 @@ func (s *WikiAttachmentService) Attach(wikiID int, attachmentIDs []int) ([]*Atta
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-list-of-wiki-attachments
 func (s *WikiAttachmentService) List(wikiID int) ([]*Attachment, error) {
+	return s.ListContext(context.Background(), wikiID)
+}
+
+// ListContext is like List but with the context.
+func (s *WikiAttachmentService) ListContext(ctx context.Context, wikiID int) ([]*Attachment, error) {
 	spath := "wikis/" + strconv.Itoa(wikiID) + "/attachments"
-	return listAttachments(s.method.Get, spath)
+	return listAttachments(ctx, s.method.Get, spath)
+}
+
+// Download downloads a file attached to the wiki.
+// The returned file must be closed after use.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-wiki-page-attachment
+func (s *WikiAttachmentService) Download(wikiID, attachmentID int) (*File, error) {
+	return s.DownloadContext(context.Background(), wikiID, attachmentID)
+}
+
+// DownloadContext is like Download but with the context.
+// The context is also used while reading the returned file.
+func (s *WikiAttachmentService) DownloadContext(ctx context.Context, wikiID, attachmentID int) (*File, error) {
+	spath := "wikis/" + strconv.Itoa(wikiID) + "/attachments"
+	return downloadAttachment(ctx, s.method.Download, spath, attachmentID)
 }
 
 // Remove removes a file attached to the wiki.
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/remove-wiki-attachment
 func (s *WikiAttachmentService) Remove(wikiID, attachmentID int) (*Attachment, error) {
+	return s.RemoveContext(context.Background(), wikiID, attachmentID)
+}
+
+// RemoveContext is like Remove but with the context.
+func (s *WikiAttachmentService) RemoveContext(ctx context.Context, wikiID, attachmentID int) (*Attachment, error) {
 	spath := "wikis/" + strconv.Itoa(wikiID) + "/attachments/" + strconv.Itoa(attachmentID)
-	return removeAttachment(s.method.Delete, spath)
+	return removeAttachment(ctx, s.method.Delete, spath)
 }
 
 // IssueAttachmentService hs methods for attachment file of issue.
@@ -114,16 +208,41 @@ type IssueAttachmentService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-list-of-issue-attachments
 func (s *IssueAttachmentService) List(issueIDOrKey string) ([]*Attachment, error) {
+	return s.ListContext(context.Background(), issueIDOrKey)
+}
+
+// ListContext is like List but with the context.
+func (s *IssueAttachmentService) ListContext(ctx context.Context, issueIDOrKey string) ([]*Attachment, error) {
 	spath := "issues/" + issueIDOrKey + "/attachments"
-	return listAttachments(s.method.Get, spath)
+	return listAttachments(ctx, s.method.Get, spath)
+}
+
+// Download downloads a file attached to the issue.
+// The returned file must be closed after use.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-issue-attachment
+func (s *IssueAttachmentService) Download(issueIDOrKey string, attachmentID int) (*File, error) {
+	return s.DownloadContext(context.Background(), issueIDOrKey, attachmentID)
+}
+
+// DownloadContext is like Download but with the context.
+// The context is also used while reading the returned file.
+func (s *IssueAttachmentService) DownloadContext(ctx context.Context, issueIDOrKey string, attachmentID int) (*File, error) {
+	spath := "issues/" + issueIDOrKey + "/attachments"
+	return downloadAttachment(ctx, s.method.Download, spath, attachmentID)
 }
 
 // Remove removes a file attached to the issue.
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-issue-attachment
 func (s *IssueAttachmentService) Remove(issueIDOrKey string, attachmentID int) (*Attachment, error) {
+	return s.RemoveContext(context.Background(), issueIDOrKey, attachmentID)
+}
+
+// RemoveContext is like Remove but with the context.
+func (s *IssueAttachmentService) RemoveContext(ctx context.Context, issueIDOrKey string, attachmentID int) (*Attachment, error) {
 	spath := "issues/" + issueIDOrKey + "/attachments/" + strconv.Itoa(attachmentID)
-	return removeAttachment(s.method.Delete, spath)
+	return removeAttachment(ctx, s.method.Delete, spath)
 }
 
 // PullRequestAttachmentService hs methods for attachment file of pull request.
@@ -135,14 +254,39 @@ type PullRequestAttachmentService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-list-of-pull-request-attachment
 func (s *PullRequestAttachmentService) List(projectIDOrKey, repoIDOrName string, prNumber int) ([]*Attachment, error) {
+	return s.ListContext(context.Background(), projectIDOrKey, repoIDOrName, prNumber)
+}
+
+// ListContext is like List but with the context.
+func (s *PullRequestAttachmentService) ListContext(ctx context.Context, projectIDOrKey, repoIDOrName string, prNumber int) ([]*Attachment, error) {
 	spath := "projects/" + projectIDOrKey + "/git/repositories/" + repoIDOrName + "/pullRequests/" + strconv.Itoa(prNumber) + "/attachments"
-	return listAttachments(s.method.Get, spath)
+	return listAttachments(ctx, s.method.Get, spath)
+}
+
+// Download downloads a file attached to the pull request.
+// The returned file must be closed after use.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/download-pull-request-attachment
+func (s *PullRequestAttachmentService) Download(projectIDOrKey, repoIDOrName string, prNumber int, attachmentID int) (*File, error) {
+	return s.DownloadContext(context.Background(), projectIDOrKey, repoIDOrName, prNumber, attachmentID)
+}
+
+// DownloadContext is like Download but with the context.
+// The context is also used while reading the returned file.
+func (s *PullRequestAttachmentService) DownloadContext(ctx context.Context, projectIDOrKey, repoIDOrName string, prNumber int, attachmentID int) (*File, error) {
+	spath := "projects/" + projectIDOrKey + "/git/repositories/" + repoIDOrName + "/pullRequests/" + strconv.Itoa(prNumber) + "/attachments"
+	return downloadAttachment(ctx, s.method.Download, spath, attachmentID)
 }
 
 // Remove removes a file attached to the pull request.
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-pull-request-attachments
 func (s *PullRequestAttachmentService) Remove(projectIDOrKey, repoIDOrName string, prNumber int, attachmentID int) (*Attachment, error) {
-	spath := "projects/" + projectIDOrKey + "/git/repositories/" + repoIDOrName + "/pullRequests/" + strconv.Itoa(prNumber) + "/attachments" + strconv.Itoa(attachmentID)
-	return removeAttachment(s.method.Delete, spath)
+	return s.RemoveContext(context.Background(), projectIDOrKey, repoIDOrName, prNumber, attachmentID)
+}
+
+// RemoveContext is like Remove but with the context.
+func (s *PullRequestAttachmentService) RemoveContext(ctx context.Context, projectIDOrKey, repoIDOrName string, prNumber int, attachmentID int) (*Attachment, error) {
+	spath := "projects/" + projectIDOrKey + "/git/repositories/" + repoIDOrName + "/pullRequests/" + strconv.Itoa(prNumber) + "/attachments/" + strconv.Itoa(attachmentID)
+	return removeAttachment(ctx, s.method.Delete, spath)
 }
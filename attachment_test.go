@@ -1,10 +1,17 @@
 package backlog_test
 
 import (
+	"bytes"
+	"context"
 	"errors"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
 	"net/http"
 	"os"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -39,7 +46,7 @@ func TestSpaceAttachmentService_Uploade(t *testing.T) {
 	}
 	s := &backlog.SpaceAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Uploade: func(spath, fpath, fname string) (*backlog.ExportResponse, error) {
+		Uploade: func(ctx context.Context, spath, fpath, fname string) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			assert.Equal(t, want.fpath, fpath)
 			assert.Equal(t, want.fname, fname)
@@ -62,7 +69,7 @@ func TestSpaceAttachmentService_Uploade(t *testing.T) {
 func TestSpaceAttachmentService_Uploade_clientError(t *testing.T) {
 	s := &backlog.SpaceAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Uploade: func(spath, fpath, fname string) (*backlog.ExportResponse, error) {
+		Uploade: func(ctx context.Context, spath, fpath, fname string) (*backlog.ExportResponse, error) {
 			return nil, errors.New("error")
 		},
 	})
@@ -80,7 +87,7 @@ func TestSpaceAttachmentService_Uploade_invalidJson(t *testing.T) {
 
 	s := &backlog.SpaceAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Uploade: func(spath, fpath, fname string) (*backlog.ExportResponse, error) {
+		Uploade: func(ctx context.Context, spath, fpath, fname string) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -117,7 +124,7 @@ func TestWikiAttachmentService_Attach(t *testing.T) {
 	}
 	s := &backlog.WikiAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Post: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			v := *params.ExportURLValues()
 			assert.Equal(t, []string{"2"}, v["attachmentId[]"])
@@ -143,7 +150,7 @@ func TestWikiAttachmentService_Attach_clientError(t *testing.T) {
 	s := &backlog.WikiAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Post: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			return nil, errors.New("error")
 		},
 	})
@@ -162,7 +169,7 @@ func TestWikiAttachmentService_Attach_invalidJson(t *testing.T) {
 	s := &backlog.WikiAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Post: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -200,7 +207,7 @@ func TestWikiAttachmentService_List(t *testing.T) {
 	s := &backlog.WikiAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
@@ -224,7 +231,7 @@ func TestWikiAttachmentService_List_clientError(t *testing.T) {
 	s := &backlog.WikiAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			return nil, errors.New("error")
 		},
 	})
@@ -243,7 +250,7 @@ func TestWikiAttachmentService_List_invalidJson(t *testing.T) {
 	s := &backlog.WikiAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -282,7 +289,7 @@ func TestWikiAttachmentService_Remove(t *testing.T) {
 	s := &backlog.WikiAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
@@ -306,7 +313,7 @@ func TestWikiAttachmentService_Remove_clientError(t *testing.T) {
 	s := &backlog.WikiAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			return nil, errors.New("error")
 		},
 	})
@@ -325,7 +332,7 @@ func TestWikiAttachmentService_Remove_invalidJson(t *testing.T) {
 	s := &backlog.WikiAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -362,7 +369,7 @@ func TestIssueAttachmentService_List(t *testing.T) {
 	}
 	s := &backlog.IssueAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
@@ -385,7 +392,7 @@ func TestIssueAttachmentService_List(t *testing.T) {
 func TestIssueAttachmentService_List_clientError(t *testing.T) {
 	s := &backlog.IssueAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			return nil, errors.New("error")
 		},
 	})
@@ -403,7 +410,7 @@ func TestIssueAttachmentService_List_invalidJson(t *testing.T) {
 
 	s := &backlog.IssueAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -442,7 +449,7 @@ func TestIssueAttachmentService_Remove(t *testing.T) {
 
 	s := &backlog.IssueAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
@@ -465,7 +472,7 @@ func TestIssueAttachmentService_Remove(t *testing.T) {
 func TestIssueAttachmentService_Remove_clientError(t *testing.T) {
 	s := &backlog.IssueAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			return nil, errors.New("error")
 		},
 	})
@@ -483,7 +490,7 @@ func TestIssueAttachmentService_Remove_invalidJson(t *testing.T) {
 
 	s := &backlog.IssueAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -522,7 +529,7 @@ func TestPullRequestAttachmentService_List(t *testing.T) {
 	}
 	s := &backlog.PullRequestAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
@@ -545,7 +552,7 @@ func TestPullRequestAttachmentService_List(t *testing.T) {
 func TestPullRequestAttachmentService_List_clientError(t *testing.T) {
 	s := &backlog.PullRequestAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			return nil, errors.New("error")
 		},
 	})
@@ -563,7 +570,7 @@ func TestPullRequestAttachmentService_List_invalidJson(t *testing.T) {
 
 	s := &backlog.PullRequestAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -595,7 +602,7 @@ func TestPullRequestAttachmentService_Remove(t *testing.T) {
 		size    int
 		created time.Time
 	}{
-		spath:   "projects/" + projectIDOrKey + "/git/repositories/" + repoIDOrName + "/pullRequests/" + strconv.Itoa(prNumber) + "/attachments" + strconv.Itoa(attachmentID),
+		spath:   "projects/" + projectIDOrKey + "/git/repositories/" + repoIDOrName + "/pullRequests/" + strconv.Itoa(prNumber) + "/attachments/" + strconv.Itoa(attachmentID),
 		id:      8,
 		name:    "IMG0088.png",
 		size:    5563,
@@ -603,7 +610,7 @@ func TestPullRequestAttachmentService_Remove(t *testing.T) {
 	}
 	s := &backlog.PullRequestAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
@@ -626,7 +633,7 @@ func TestPullRequestAttachmentService_Remove(t *testing.T) {
 func TestPullRequestAttachmentService_Remove_clientError(t *testing.T) {
 	s := &backlog.PullRequestAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			return nil, errors.New("error")
 		},
 	})
@@ -644,7 +651,7 @@ func TestPullRequestAttachmentService_Remove_invalidJson(t *testing.T) {
 
 	s := &backlog.PullRequestAttachmentService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -656,3 +663,315 @@ func TestPullRequestAttachmentService_Remove_invalidJson(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, attachment)
 }
+
+func newDownloadResponse(name, contentType, content string) *backlog.ExportResponse {
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+	header.Set("Content-Disposition", `attachment; filename="`+name+`"`)
+	resp := &http.Response{
+		StatusCode:    http.StatusOK,
+		Header:        header,
+		ContentLength: int64(len(content)),
+		Body:          ioutil.NopCloser(strings.NewReader(content)),
+	}
+	return backlog.ExportNewResponse(resp)
+}
+
+func TestWikiAttachmentService_Download(t *testing.T) {
+	s := &backlog.WikiAttachmentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Download: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "wikis/1234/attachments/8", spath)
+			return newDownloadResponse("IMG0088.png", "image/png", "content"), nil
+		},
+	})
+
+	f, err := s.Download(1234, 8)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer f.Close()
+
+	assert.Equal(t, "IMG0088.png", f.Name)
+	assert.Equal(t, "image/png", f.ContentType)
+	assert.Equal(t, int64(7), f.Size)
+	b, _ := ioutil.ReadAll(f)
+	assert.Equal(t, "content", string(b))
+}
+
+func TestIssueAttachmentService_Download(t *testing.T) {
+	s := &backlog.IssueAttachmentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Download: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "issues/TEST-1/attachments/8", spath)
+			return newDownloadResponse("test.txt", "text/plain", "content"), nil
+		},
+	})
+
+	f, err := s.Download("TEST-1", 8)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer f.Close()
+
+	assert.Equal(t, "test.txt", f.Name)
+	assert.Equal(t, "text/plain", f.ContentType)
+}
+
+func TestPullRequestAttachmentService_Download(t *testing.T) {
+	s := &backlog.PullRequestAttachmentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Download: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/git/repositories/app/pullRequests/1/attachments/8", spath)
+			return newDownloadResponse("diff.patch", "application/octet-stream", "content"), nil
+		},
+	})
+
+	f, err := s.Download("TEST", "app", 1, 8)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer f.Close()
+
+	assert.Equal(t, "diff.patch", f.Name)
+}
+
+func TestAttachmentService_Download_param_error(t *testing.T) {
+	m := &backlog.ExportMethod{
+		Download: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Download must never be called")
+			return nil, errors.New("error")
+		},
+	}
+
+	ws := &backlog.WikiAttachmentService{}
+	ws.ExportSetMethod(m)
+	f, err := ws.Download(1234, 0)
+	assert.Nil(t, f)
+	assert.Error(t, err)
+
+	is := &backlog.IssueAttachmentService{}
+	is.ExportSetMethod(m)
+	f, err = is.Download("TEST-1", 0)
+	assert.Nil(t, f)
+	assert.Error(t, err)
+
+	ps := &backlog.PullRequestAttachmentService{}
+	ps.ExportSetMethod(m)
+	f, err = ps.Download("TEST", "app", 1, 0)
+	assert.Nil(t, f)
+	assert.Error(t, err)
+}
+
+func TestIssueAttachmentService_Download_clientError(t *testing.T) {
+	s := &backlog.IssueAttachmentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Download: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			return nil, errors.New("error")
+		},
+	})
+
+	f, err := s.Download("TEST-1", 8)
+	assert.Nil(t, f)
+	assert.Error(t, err)
+}
+
+func TestIssueAttachmentService_Download_client(t *testing.T) {
+	c := NewClientMock("https://test.backlog.com", "apikey", func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v2/issues/TEST-1/attachments/8", req.URL.Path)
+		assert.Equal(t, "*/*", req.Header.Get("Accept"))
+
+		header := http.Header{}
+		header.Set("Content-Type", "text/plain")
+		header.Set("Content-Disposition", `attachment; filename*=UTF-8''%E3%83%86%E3%82%B9%E3%83%88.txt`)
+		return &http.Response{
+			StatusCode:    http.StatusOK,
+			Header:        header,
+			ContentLength: 7,
+			Body:          ioutil.NopCloser(strings.NewReader("content")),
+		}, nil
+	})
+
+	f, err := c.Issue.Attachment.Download("TEST-1", 8)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer f.Close()
+
+	assert.Equal(t, "テスト.txt", f.Name)
+	b, _ := ioutil.ReadAll(f)
+	assert.Equal(t, "content", string(b))
+}
+
+func readUploadedPart(t *testing.T, req *http.Request) (*multipart.Part, []byte) {
+	_, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := multipart.NewReader(req.Body, params["boundary"])
+	part, err := r.NextPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(part)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.NextPart(); err != io.EOF {
+		t.Errorf("want: io.EOF, got: %v", err)
+	}
+	return part, b
+}
+
+func TestSpaceAttachmentService_UploadReader(t *testing.T) {
+	content := strings.Repeat("content", 1024)
+
+	c := NewClientMock("https://test.backlog.com", "apikey", func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "/api/v2/space/attachment", req.URL.Path)
+
+		b, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, int64(len(b)), req.ContentLength)
+
+		req.Body = ioutil.NopCloser(bytes.NewReader(b))
+		part, got := readUploadedPart(t, req)
+		assert.Equal(t, "file", part.FormName())
+		assert.Equal(t, `test "1".txt`, part.FileName())
+		assert.Equal(t, "text/plain", part.Header.Get("Content-Type"))
+		assert.Equal(t, content, string(got))
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(`{"id":1,"name":"test.txt","size":7168}`)),
+		}, nil
+	})
+
+	var written, total int64
+	o := c.Space.Attachment.Option
+	attachment, err := c.Space.Attachment.UploadReader(
+		`test "1".txt`, strings.NewReader(content),
+		o.WithSize(int64(len(content))),
+		o.WithContentType("text/plain"),
+		o.WithProgress(func(w, t int64) {
+			written, total = w, t
+		}),
+	)
+	assert.NoError(t, err)
+	if assert.NotNil(t, attachment) {
+		assert.Equal(t, 1, attachment.ID)
+	}
+	assert.Equal(t, int64(len(content)), written)
+	assert.Equal(t, int64(len(content)), total)
+}
+
+func TestSpaceAttachmentService_UploadReader_unknownSize(t *testing.T) {
+	c := NewClientMock("https://test.backlog.com", "apikey", func(req *http.Request) (*http.Response, error) {
+		assert.Zero(t, req.ContentLength)
+
+		part, got := readUploadedPart(t, req)
+		assert.Equal(t, "application/octet-stream", part.Header.Get("Content-Type"))
+		assert.Equal(t, "content", string(got))
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(`{"id":1}`)),
+		}, nil
+	})
+
+	var total int64
+	attachment, err := c.Space.Attachment.UploadReader(
+		"test.txt", strings.NewReader("content"),
+		c.Space.Attachment.Option.WithProgress(func(w, t int64) {
+			total = t
+		}),
+	)
+	assert.NoError(t, err)
+	assert.NotNil(t, attachment)
+	assert.Equal(t, int64(-1), total)
+}
+
+type errorReader struct {
+	err error
+}
+
+func (r errorReader) Read(p []byte) (int, error) {
+	return 0, r.err
+}
+
+func TestSpaceAttachmentService_UploadReader_readerError(t *testing.T) {
+	c := NewClientMock("https://test.backlog.com", "apikey", func(req *http.Request) (*http.Response, error) {
+		_, err := ioutil.ReadAll(req.Body)
+		return nil, err
+	})
+
+	readErr := errors.New("read error")
+	r := io.MultiReader(strings.NewReader("content"), errorReader{err: readErr})
+	attachment, err := c.Space.Attachment.UploadReader("test.txt", r)
+	assert.Nil(t, attachment)
+	assert.Equal(t, readErr, err)
+}
+
+func TestSpaceAttachmentService_UploadReader_transportError(t *testing.T) {
+	c := NewClientMock("https://test.backlog.com", "apikey", func(req *http.Request) (*http.Response, error) {
+		// The body is never read.
+		return nil, errors.New("transport error")
+	})
+
+	// The pipe has no buffer, so the writer blocks until the pipe is closed.
+	r := strings.NewReader(strings.Repeat("a", 1<<20))
+	attachment, err := c.Space.Attachment.UploadReader("test.txt", r)
+	assert.Nil(t, attachment)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "transport error")
+	}
+}
+
+func TestSpaceAttachmentService_UploadReader_param_error(t *testing.T) {
+	o := &backlog.UploadOptionService{}
+	cases := map[string]struct {
+		fname   string
+		reader  io.Reader
+		options []backlog.UploadOption
+	}{
+		"empty_name": {
+			fname:  "",
+			reader: strings.NewReader("content"),
+		},
+		"nil_reader": {
+			fname:  "test.txt",
+			reader: nil,
+		},
+		"negative_size": {
+			fname:   "test.txt",
+			reader:  strings.NewReader("content"),
+			options: []backlog.UploadOption{o.WithSize(-1)},
+		},
+		"empty_content_type": {
+			fname:   "test.txt",
+			reader:  strings.NewReader("content"),
+			options: []backlog.UploadOption{o.WithContentType("")},
+		},
+		"nil_progress": {
+			fname:   "test.txt",
+			reader:  strings.NewReader("content"),
+			options: []backlog.UploadOption{o.WithProgress(nil)},
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			c := NewClientMock("https://test.backlog.com", "apikey", func(req *http.Request) (*http.Response, error) {
+				t.Error("httpClient.Do must never be called")
+				return nil, errors.New("error")
+			})
+
+			attachment, err := c.Space.Attachment.UploadReader(tc.fname, tc.reader, tc.options...)
+			assert.Nil(t, attachment)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -1,6 +1,138 @@
 package backlog
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+func decodeCategory(resp *response) (*Category, error) {
+	defer resp.Body.Close()
+
+	v := Category{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
 // CategoryService has methods for Category.
 type CategoryService struct {
 	method *method
 }
+
+// All returns a list of categories in the project.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-category-list
+func (s *CategoryService) All(target ProjectIDOrKeyGetter) ([]*Category, error) {
+	return s.AllContext(context.Background(), target)
+}
+
+// AllContext is like All but with the context.
+func (s *CategoryService) AllContext(ctx context.Context, target ProjectIDOrKeyGetter) ([]*Category, error) {
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return nil, err
+	}
+	spath := "projects/" + projectIDOrKey + "/categories"
+
+	resp, err := s.method.Get(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := []*Category{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// Create creates a new category in the project.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-category
+func (s *CategoryService) Create(target ProjectIDOrKeyGetter, name string) (*Category, error) {
+	return s.CreateContext(context.Background(), target, name)
+}
+
+// CreateContext is like Create but with the context.
+func (s *CategoryService) CreateContext(ctx context.Context, target ProjectIDOrKeyGetter, name string) (*Category, error) {
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return nil, err
+	}
+	spath := "projects/" + projectIDOrKey + "/categories"
+
+	params := newRequestParams()
+	if err := withName(name)(params); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.method.Post(ctx, spath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeCategory(resp)
+}
+
+// Update renames the category in the project.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-category
+func (s *CategoryService) Update(target ProjectIDOrKeyGetter, categoryID int, name string) (*Category, error) {
+	return s.UpdateContext(context.Background(), target, categoryID, name)
+}
+
+// UpdateContext is like Update but with the context.
+func (s *CategoryService) UpdateContext(ctx context.Context, target ProjectIDOrKeyGetter, categoryID int, name string) (*Category, error) {
+	if categoryID < 1 {
+		return nil, fmt.Errorf("categoryID must be 1 or more: %d", categoryID)
+	}
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return nil, err
+	}
+	spath := "projects/" + projectIDOrKey + "/categories/" + strconv.Itoa(categoryID)
+
+	params := newRequestParams()
+	if err := withName(name)(params); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.method.Patch(ctx, spath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeCategory(resp)
+}
+
+// Delete deletes the category in the project.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-category
+func (s *CategoryService) Delete(target ProjectIDOrKeyGetter, categoryID int) (*Category, error) {
+	return s.DeleteContext(context.Background(), target, categoryID)
+}
+
+// DeleteContext is like Delete but with the context.
+func (s *CategoryService) DeleteContext(ctx context.Context, target ProjectIDOrKeyGetter, categoryID int) (*Category, error) {
+	if categoryID < 1 {
+		return nil, fmt.Errorf("categoryID must be 1 or more: %d", categoryID)
+	}
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return nil, err
+	}
+	spath := "projects/" + projectIDOrKey + "/categories/" + strconv.Itoa(categoryID)
+
+	resp, err := s.method.Delete(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeCategory(resp)
+}
@@ -0,0 +1,150 @@
+package backlog_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCategoryService_All(t *testing.T) {
+	bj, err := os.Open("testdata/json/category_list.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.CategoryService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/categories", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	categories, err := s.All(backlog.ProjectKey("TEST"))
+	assert.NoError(t, err)
+	assert.Len(t, categories, 2)
+	assert.Equal(t, 13, categories[1].ID)
+	assert.Equal(t, "Design", categories[1].Name)
+}
+
+func TestCategoryService_Create(t *testing.T) {
+	bj, err := os.Open("testdata/json/category.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.CategoryService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/categories", spath)
+			assert.Equal(t, "Development", params.Get("name"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	category, err := s.Create(backlog.ProjectKey("TEST"), "Development")
+	assert.NoError(t, err)
+	assert.Equal(t, 12, category.ID)
+}
+
+func TestCategoryService_Update(t *testing.T) {
+	bj, err := os.Open("testdata/json/category.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.CategoryService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/categories/12", spath)
+			assert.Equal(t, "Development", params.Get("name"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	category, err := s.Update(backlog.ProjectKey("TEST"), 12, "Development")
+	assert.NoError(t, err)
+	assert.Equal(t, 12, category.ID)
+}
+
+func TestCategoryService_Delete(t *testing.T) {
+	bj, err := os.Open("testdata/json/category.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.CategoryService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/categories/12", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	category, err := s.Delete(backlog.ProjectKey("TEST"), 12)
+	assert.NoError(t, err)
+	assert.Equal(t, 12, category.ID)
+}
+
+func TestCategoryService_param_error(t *testing.T) {
+	s := &backlog.CategoryService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Post must never be called")
+			return nil, errors.New("error")
+		},
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Patch must never be called")
+			return nil, errors.New("error")
+		},
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Delete must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	category, err := s.Create(backlog.ProjectKey("TEST"), "")
+	assert.Nil(t, category)
+	assert.Error(t, err)
+
+	category, err = s.Update(backlog.ProjectKey("TEST"), 0, "Development")
+	assert.Nil(t, category)
+	assert.Error(t, err)
+
+	category, err = s.Update(backlog.ProjectKey("TEST"), 12, "")
+	assert.Nil(t, category)
+	assert.Error(t, err)
+
+	category, err = s.Delete(backlog.ProjectKey("TEST"), 0)
+	assert.Nil(t, category)
+	assert.Error(t, err)
+
+	category, err = s.Delete(backlog.ProjectID(0), 12)
+	assert.Nil(t, category)
+	assert.Error(t, err)
+}
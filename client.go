@@ -2,15 +2,20 @@ package backlog
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
+	"io/ioutil"
 	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	"net/url"
 	"os"
 	"path"
 	"strings"
+	"sync"
+	"time"
 )
 
 const (
@@ -32,13 +37,34 @@ func newClientError(msg string) *ClinetError {
 
 // Client is Backlog API client.
 type Client struct {
-	url        *url.URL
-	httpClient *http.Client
-	token      string
-
+	url         *url.URL
+	httpClient  *http.Client
+	httpConfig  []func(hc *http.Client)
+	token       string
+	tokenSource TokenSource
+	userAgent   string
+	logger      Logger
+	retry       *RetryPolicy
+	sleep       func(ctx context.Context, d time.Duration) error
+	middlewares []Middleware
+	roundTrip   RoundTrip
+
+	rateLimitMu        sync.Mutex
+	rateLimit          RateLimitStatus
+	rateLimitThreshold int
+
+	uploadLimitMu   sync.Mutex
+	uploadLimit     *uploadLimit
+	uploadLimitCall *uploadLimitCall
+	uploadLimitTTL  time.Duration
+
+	Git         *GitService
 	Issue       *IssueService
+	Priority    *PriorityService
 	Project     *ProjectService
 	PullRequest *PullRequestService
+	RateLimit   *RateLimitService
+	Resolution  *ResolutionService
 	Space       *SpaceService
 	User        *UserService
 	Wiki        *WikiService
@@ -48,7 +74,8 @@ type Client struct {
 // It wraps http.Response.
 type response struct {
 	*http.Response
-	Error *APIResponseError
+	Error     *APIResponseError
+	RateLimit *RateLimit
 }
 
 // Request wraps http.Request.
@@ -61,62 +88,241 @@ type requestParams struct {
 	*url.Values
 }
 
-type clientGet func(spath string, params *requestParams) (*response, error)
-type clientPost func(spath string, params *requestParams) (*response, error)
-type clientPatch func(spath string, params *requestParams) (*response, error)
-type clientDelete func(spath string, params *requestParams) (*response, error)
-type clientUploade func(spath, fpath, fname string) (*response, error)
+type clientGet func(ctx context.Context, spath string, params *requestParams) (*response, error)
+type clientPost func(ctx context.Context, spath string, params *requestParams) (*response, error)
+type clientPatch func(ctx context.Context, spath string, params *requestParams) (*response, error)
+type clientPut func(ctx context.Context, spath string, params *requestParams) (*response, error)
+type clientDelete func(ctx context.Context, spath string, params *requestParams) (*response, error)
+type clientUploade func(ctx context.Context, spath, fpath, fname string) (*response, error)
+type clientUploadReader func(ctx context.Context, spath string, f *uploadFile) (*response, error)
+type clientDownload func(ctx context.Context, spath string, params *requestParams) (*response, error)
 
 type method struct {
-	Get     clientGet
-	Post    clientPost
-	Patch   clientPatch
-	Delete  clientDelete
-	Uploade clientUploade
+	Get      clientGet
+	Post     clientPost
+	Patch    clientPatch
+	Put      clientPut
+	Delete   clientDelete
+	Uploade  clientUploade
+	Download clientDownload
+
+	UploadReader clientUploadReader
+}
+
+// Logger is the interface to write debug log of the client.
+// *log.Logger implements this interface.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// ClientOption is type of functional option for NewClient.
+type ClientOption func(c *Client) error
+
+// WithHTTPClient returns option. the option sets http.Client used to send requests.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return newClientError("httpClient must not be nil")
+		}
+		c.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithUserAgent returns option. the option sets User-Agent header of requests.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		if userAgent == "" {
+			return newClientError("userAgent must not be empty")
+		}
+		c.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithTimeout returns option. the option sets time limit for each request.
+// It is applied to a copy of the http.Client given by WithHTTPClient regardless of the order of options.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) error {
+		if timeout < 0 {
+			return newClientError("timeout must not be negative")
+		}
+		c.httpConfig = append(c.httpConfig, func(hc *http.Client) {
+			hc.Timeout = timeout
+		})
+		return nil
+	}
+}
+
+// WithBaseTransport returns option. the option sets http.RoundTripper used to send requests.
+// Use it to configure proxy, TLS and so on with http.Transport.
+// It is applied to a copy of the http.Client given by WithHTTPClient regardless of the order of options.
+func WithBaseTransport(transport http.RoundTripper) ClientOption {
+	return func(c *Client) error {
+		if transport == nil {
+			return newClientError("transport must not be nil")
+		}
+		c.httpConfig = append(c.httpConfig, func(hc *http.Client) {
+			hc.Transport = transport
+		})
+		return nil
+	}
+}
+
+// WithDebugLogger returns option. the option writes requests and responses to the logger.
+func WithDebugLogger(logger Logger) ClientOption {
+	return func(c *Client) error {
+		if logger == nil {
+			return newClientError("logger must not be nil")
+		}
+		c.logger = logger
+		return nil
+	}
+}
+
+// WithRetryPolicy returns option. the option makes the client retry requests by the policy.
+// Requests are never retried without this option.
+func WithRetryPolicy(policy *RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		if policy == nil {
+			return newClientError("retry policy must not be nil")
+		}
+		if policy.MaxAttempts < 1 {
+			return newClientError("MaxAttempts of retry policy must be 1 or more")
+		}
+		c.retry = policy
+		return nil
+	}
+}
+
+// WithRateLimitWait returns option. the option makes the client wait before a request
+// until the rate limit is reset when the remaining is less than or equal to the threshold.
+func WithRateLimitWait(threshold int) ClientOption {
+	return func(c *Client) error {
+		if threshold < 0 {
+			return newClientError("threshold must be 0 or more")
+		}
+		c.rateLimitThreshold = threshold
+		return nil
+	}
+}
+
+// WithUploadLimitCheck returns option. the option makes the client check the size of files
+// against the licence and the disk usage of the space before uploading,
+// and reject them with UploadLimitError without sending.
+// The licence and the disk usage are cached for the ttl.
+// Files uploaded from io.Reader are checked only if the size is set.
+func WithUploadLimitCheck(ttl time.Duration) ClientOption {
+	return func(c *Client) error {
+		if ttl <= 0 {
+			return newClientError("ttl must be positive")
+		}
+		c.uploadLimitTTL = ttl
+		return nil
+	}
 }
 
 // NewClient creates a new Backlog API Client.
-func NewClient(baseURL, token string) (*Client, error) {
+// The token is API key of Backlog.
+func NewClient(baseURL, token string, options ...ClientOption) (*Client, error) {
 	if len(token) == 0 {
 		return nil, newClientError("missing token")
 	}
 
+	return newClient(baseURL, token, nil, options)
+}
+
+// NewClientWithOAuth2 creates a new Backlog API Client authorized by OAuth 2.0.
+// The access token supplied by the token source is sent with Authorization header.
+func NewClientWithOAuth2(baseURL string, tokenSource TokenSource, options ...ClientOption) (*Client, error) {
+	if tokenSource == nil {
+		return nil, newClientError("missing token source")
+	}
+
+	return newClient(baseURL, "", tokenSource, options)
+}
+
+func newClient(baseURL, token string, tokenSource TokenSource, options []ClientOption) (*Client, error) {
 	parsedURL, err := url.ParseRequestURI(baseURL)
 	if err != nil {
 		return nil, err
 	}
 
 	c := &Client{
-		url:        parsedURL,
-		httpClient: http.DefaultClient,
-		token:      token,
+		url:         parsedURL,
+		httpClient:  http.DefaultClient,
+		token:       token,
+		tokenSource: tokenSource,
+		sleep:       sleep,
+
+		rateLimitThreshold: -1,
+	}
+
+	for _, option := range options {
+		if err := option(c); err != nil {
+			return nil, err
+		}
 	}
 
+	// The given http.Client is shared with the caller, so it is configured on a copy.
+	if len(c.httpConfig) > 0 {
+		hc := *c.httpClient
+		for _, config := range c.httpConfig {
+			config(&hc)
+		}
+		c.httpClient = &hc
+	}
+
+	c.roundTrip = chainMiddlewares(func(req *http.Request) (*http.Response, error) {
+		return c.httpClient.Do(req)
+	}, c.middlewares)
+
 	m := &method{
-		Get: func(spath string, params *requestParams) (*response, error) {
-			return c.get(spath, params)
+		Get: func(ctx context.Context, spath string, params *requestParams) (*response, error) {
+			return c.get(ctx, spath, params)
+		},
+		Post: func(ctx context.Context, spath string, params *requestParams) (*response, error) {
+			return c.post(ctx, spath, params)
+		},
+		Patch: func(ctx context.Context, spath string, params *requestParams) (*response, error) {
+			return c.patch(ctx, spath, params)
 		},
-		Post: func(spath string, params *requestParams) (*response, error) {
-			return c.post(spath, params)
+		Put: func(ctx context.Context, spath string, params *requestParams) (*response, error) {
+			return c.put(ctx, spath, params)
 		},
-		Patch: func(spath string, params *requestParams) (*response, error) {
-			return c.patch(spath, params)
+		Delete: func(ctx context.Context, spath string, params *requestParams) (*response, error) {
+			return c.delete(ctx, spath, params)
 		},
-		Delete: func(spath string, params *requestParams) (*response, error) {
-			return c.delete(spath, params)
+		Uploade: func(ctx context.Context, spath, fpath, fname string) (*response, error) {
+			return c.uploade(ctx, spath, fpath, fname)
 		},
-		Uploade: func(spath, fpath, fname string) (*response, error) {
-			return c.uploade(spath, fpath, fname)
+		Download: func(ctx context.Context, spath string, params *requestParams) (*response, error) {
+			return c.download(ctx, spath, params)
+		},
+		UploadReader: func(ctx context.Context, spath string, f *uploadFile) (*response, error) {
+			return c.uploadReader(ctx, spath, f)
 		},
 	}
 
 	activityOptionService := &ActivityOptionService{}
 
+	c.Git = &GitService{
+		method: m,
+	}
 	c.Issue = &IssueService{
 		method: m,
 		Attachment: &IssueAttachmentService{
 			method: m,
 		},
+		Comment: &IssueCommentService{
+			method: m,
+			Option: &CommentOptionService{},
+		},
+		ListOption: &IssueListOptionService{},
+		Option:     &IssueOptionService{},
+	}
+	c.Priority = &PriorityService{
+		method: m,
 	}
 	c.Project = &ProjectService{
 		method: m,
@@ -124,9 +330,28 @@ func NewClient(baseURL, token string) (*Client, error) {
 			method: m,
 			Option: activityOptionService,
 		},
+		Category: &CategoryService{
+			method: m,
+		},
+		CustomField: &CustomFieldService{
+			method: m,
+			Option: &CustomFieldOptionService{},
+		},
+		IssueType: &IssueTypeService{
+			method: m,
+			Option: &IssueTypeOptionService{},
+		},
+		Status: &StatusService{
+			method: m,
+			Option: &StatusOptionService{},
+		},
 		User: &ProjectUserService{
 			method: m,
 		},
+		Version: &VersionService{
+			method: m,
+			Option: &VersionOptionService{},
+		},
 		Option: &ProjectOptionService{},
 	}
 	c.PullRequest = &PullRequestService{
@@ -134,6 +359,17 @@ func NewClient(baseURL, token string) (*Client, error) {
 		Attachment: &PullRequestAttachmentService{
 			method: m,
 		},
+		Comment: &PullRequestCommentService{
+			method: m,
+			Option: &CommentOptionService{},
+		},
+		Option: &PullRequestOptionService{},
+	}
+	c.RateLimit = &RateLimitService{
+		method: m,
+	}
+	c.Resolution = &ResolutionService{
+		method: m,
 	}
 	c.Space = &SpaceService{
 		method: m,
@@ -143,6 +379,7 @@ func NewClient(baseURL, token string) (*Client, error) {
 		},
 		Attachment: &SpaceAttachmentService{
 			method: m,
+			Option: &UploadOptionService{},
 		},
 	}
 	c.User = &UserService{
@@ -158,6 +395,16 @@ func NewClient(baseURL, token string) (*Client, error) {
 		Attachment: &WikiAttachmentService{
 			method: m,
 		},
+		History: &WikiHistoryService{
+			method: m,
+			Option: &WikiHistoryOptionService{},
+		},
+		Star: &WikiStarService{
+			method: m,
+		},
+		Tag: &WikiTagService{
+			method: m,
+		},
 		Option: &WikiOptionService{},
 	}
 
@@ -165,7 +412,10 @@ func NewClient(baseURL, token string) (*Client, error) {
 }
 
 // Creates new request.
-func (c *Client) newReqest(method, spath string, params *requestParams, body io.Reader) (*request, error) {
+func (c *Client) newReqest(ctx context.Context, method, spath string, params *requestParams, body io.Reader) (*request, error) {
+	if ctx == nil {
+		return nil, errors.New("ctx must not be nil")
+	}
 	if spath == "" {
 		return nil, errors.New("spath must not empty")
 	}
@@ -173,7 +423,9 @@ func (c *Client) newReqest(method, spath string, params *requestParams, body io.
 	if params == nil {
 		params = newRequestParams()
 	}
-	params.Set("apiKey", c.token)
+	if c.tokenSource == nil {
+		params.Set("apiKey", c.token)
+	}
 
 	u := *c.url
 	u.Path = path.Join(u.Path, "api", apiVersion, spath)
@@ -181,30 +433,96 @@ func (c *Client) newReqest(method, spath string, params *requestParams, body io.
 
 	req, err := http.NewRequest(method, u.String(), body)
 	if err != nil {
-		return nil, err
+		return nil, c.redact(err)
 	}
 
+	req = req.WithContext(ctx)
 	req.Header.Set("Accept", "application/json")
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	if c.tokenSource != nil {
+		t, err := c.tokenSource.Token()
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+t.AccessToken)
+	}
 
 	return &request{Request: req}, nil
 }
 
 // Do http request, and return Response.
+// The request is sent again while the retry policy allows it.
 func (c *Client) do(req *request) (*response, error) {
-	resp, err := c.httpClient.Do(req.Request)
+	ctx := req.Context()
+	resp, err := c.send(req)
+	for attempt := 1; err == nil && c.retry.shouldRetry(req.Request, resp, attempt); attempt++ {
+		wait := c.retry.backoff(resp, attempt, time.Now())
+		if resp.Body != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		if err := c.sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+		resp, err = c.send(req)
+	}
 	if err != nil {
 		return nil, err
 	}
+	if resp.Body != nil {
+		resp.Body = &contextReadCloser{ctx: req.Context(), ReadCloser: resp.Body}
+	}
+	if resp.Request == nil {
+		resp.Request = req.Request
+	}
 
 	r := newResponse(resp)
 
 	return checkResponseError(r)
 }
 
+// Send http request once.
+// It waits for the rate limit before sending and keeps the rate limit of the response.
+func (c *Client) send(req *request) (*http.Response, error) {
+	if err := c.waitRateLimit(req.Request, time.Now()); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	resp, err := c.roundTrip(req.Request)
+	if err != nil {
+		err = c.redact(err)
+		c.debugf("%s %s: %v", req.Method, req.URL.Path, err)
+		return nil, err
+	}
+	c.debugf("%s %s: %s (%s)", req.Method, req.URL.Path, resp.Status, time.Since(start))
+	c.setRateLimit(req.Request, parseRateLimit(resp.Header))
+
+	return resp, nil
+}
+
+// Write debug log if the logger is set.
+func (c *Client) debugf(format string, v ...interface{}) {
+	if c.logger == nil {
+		return
+	}
+	c.logger.Printf("backlog: "+format, v...)
+}
+
 // Get method of http reqest.
 // It creates new http reqest and do and return Response.
-func (c *Client) get(spath string, params *requestParams) (*response, error) {
-	req, err := c.newReqest(http.MethodGet, spath, params, nil)
+func (c *Client) get(ctx context.Context, spath string, params *requestParams) (*response, error) {
+	req, err := c.newReqest(ctx, http.MethodGet, spath, params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -214,11 +532,11 @@ func (c *Client) get(spath string, params *requestParams) (*response, error) {
 
 // Post method of http reqest.
 // It creates new http reqest and do and return Response.
-func (c *Client) post(spath string, params *requestParams) (*response, error) {
+func (c *Client) post(ctx context.Context, spath string, params *requestParams) (*response, error) {
 	if params == nil {
 		params = newRequestParams()
 	}
-	req, err := c.newReqest(http.MethodPost, spath, nil, strings.NewReader(params.Encode()))
+	req, err := c.newReqest(ctx, http.MethodPost, spath, nil, strings.NewReader(params.Encode()))
 	if err != nil {
 		return nil, err
 	}
@@ -230,11 +548,27 @@ func (c *Client) post(spath string, params *requestParams) (*response, error) {
 
 // Patch method of http reqest.
 // It creates new http reqest and do and return Response.
-func (c *Client) patch(spath string, params *requestParams) (*response, error) {
+func (c *Client) patch(ctx context.Context, spath string, params *requestParams) (*response, error) {
+	if params == nil {
+		params = newRequestParams()
+	}
+	req, err := c.newReqest(ctx, http.MethodPatch, spath, nil, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return c.do(req)
+}
+
+// Put method of http reqest.
+// It creates new http reqest and do and return Response.
+func (c *Client) put(ctx context.Context, spath string, params *requestParams) (*response, error) {
 	if params == nil {
 		params = newRequestParams()
 	}
-	req, err := c.newReqest(http.MethodPatch, spath, nil, strings.NewReader(params.Encode()))
+	req, err := c.newReqest(ctx, http.MethodPut, spath, nil, strings.NewReader(params.Encode()))
 	if err != nil {
 		return nil, err
 	}
@@ -246,11 +580,11 @@ func (c *Client) patch(spath string, params *requestParams) (*response, error) {
 
 // Delete method of http reqest.
 // It creates new http reqest and do and return Response.
-func (c *Client) delete(spath string, params *requestParams) (*response, error) {
+func (c *Client) delete(ctx context.Context, spath string, params *requestParams) (*response, error) {
 	if params == nil {
 		params = newRequestParams()
 	}
-	req, err := c.newReqest(http.MethodDelete, spath, nil, strings.NewReader(params.Encode()))
+	req, err := c.newReqest(ctx, http.MethodDelete, spath, nil, strings.NewReader(params.Encode()))
 	if err != nil {
 		return nil, err
 	}
@@ -260,9 +594,26 @@ func (c *Client) delete(spath string, params *requestParams) (*response, error)
 	return c.do(req)
 }
 
+// Download method of http reqest.
+// It is like Get but accepts any content type for binary responses such as files.
+// The body of the response is not read, so the caller must close it.
+func (c *Client) download(ctx context.Context, spath string, params *requestParams) (*response, error) {
+	req, err := c.newReqest(ctx, http.MethodGet, spath, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "*/*")
+
+	return c.do(req)
+}
+
 // Uploade file method used http reqest.
 // It creates new http reqest and do and return Response.
-func (c *Client) uploade(spath, fpath, fname string) (*response, error) {
+func (c *Client) uploade(ctx context.Context, spath, fpath, fname string) (*response, error) {
+	if ctx == nil {
+		return nil, errors.New("ctx must not be nil")
+	}
 	if fpath == "" || fname == "" {
 		return nil, newClientError("file's path and name is required")
 	}
@@ -276,16 +627,27 @@ func (c *Client) uploade(spath, fpath, fname string) (*response, error) {
 	}
 	defer f.Close()
 
+	if c.uploadLimitTTL > 0 {
+		info, err := f.Stat()
+		if err != nil {
+			return nil, err
+		}
+		if err := c.checkUploadLimit(ctx, info.Size()); err != nil {
+			return nil, err
+		}
+	}
+
 	fw, err := w.CreateFormFile("file", fname)
 	if err != nil {
 		return nil, err
 	}
-	if _, err = io.Copy(fw, f); err != nil {
+	if _, err = io.Copy(fw, &contextReader{ctx: ctx, Reader: f}); err != nil {
 		return nil, err
 	}
 	w.Close()
 
-	req, err := c.newReqest(http.MethodPost, spath, nil, &buf)
+	// bytes.Reader lets the request rewind the body to retry.
+	req, err := c.newReqest(ctx, http.MethodPost, spath, nil, bytes.NewReader(buf.Bytes()))
 	if err != nil {
 		return nil, err
 	}
@@ -295,6 +657,150 @@ func (c *Client) uploade(spath, fpath, fname string) (*response, error) {
 	return c.do(req)
 }
 
+// uploadFile is a file uploaded from io.Reader.
+type uploadFile struct {
+	name        string
+	reader      io.Reader
+	size        int64
+	contentType string
+	progress    func(written, total int64)
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+func (f *uploadFile) header() textproto.MIMEHeader {
+	contentType := f.contentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", `form-data; name="file"; filename="`+quoteEscaper.Replace(f.name)+`"`)
+	h.Set("Content-Type", contentType)
+
+	return h
+}
+
+// UploadReader method used http reqest.
+// It streams the multipart body through io.Pipe without buffering the file.
+// The request is never retried because the body can not be rewound.
+func (c *Client) uploadReader(ctx context.Context, spath string, f *uploadFile) (*response, error) {
+	if ctx == nil {
+		return nil, errors.New("ctx must not be nil")
+	}
+	if f == nil || f.reader == nil || f.name == "" {
+		return nil, newClientError("file's reader and name is required")
+	}
+	if f.size >= 0 {
+		if err := c.checkUploadLimit(ctx, f.size); err != nil {
+			return nil, err
+		}
+	}
+
+	pr, pw := io.Pipe()
+	w := multipart.NewWriter(pw)
+
+	req, err := c.newReqest(ctx, http.MethodPost, spath, nil, pr)
+	if err != nil {
+		pr.Close()
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	if f.size >= 0 {
+		// The length of the body is the file size and the multipart framing around it.
+		var cw countWriter
+		mw := multipart.NewWriter(&cw)
+		mw.SetBoundary(w.Boundary())
+		mw.CreatePart(f.header())
+		mw.Close()
+		req.ContentLength = int64(cw) + f.size
+	}
+
+	errc := make(chan error, 1)
+	go func() {
+		var r io.Reader = &contextReader{ctx: ctx, Reader: f.reader}
+		if f.progress != nil {
+			r = &progressReader{Reader: r, total: f.size, progress: f.progress}
+		}
+
+		part, err := w.CreatePart(f.header())
+		if err == nil {
+			_, err = io.Copy(part, r)
+		}
+		if err == nil {
+			err = w.Close()
+		}
+		pw.CloseWithError(err)
+		errc <- err
+	}()
+
+	resp, err := c.do(req)
+	if err != nil {
+		// Unblock the writer if the body is not read, and report the error of the reader rather than the transport.
+		pr.CloseWithError(err)
+		if werr := <-errc; werr != nil && werr != err && werr != io.ErrClosedPipe {
+			return nil, werr
+		}
+		return nil, err
+	}
+	pr.Close()
+	<-errc
+
+	return resp, nil
+}
+
+// countWriter is io.Writer which counts written bytes only.
+type countWriter int64
+
+func (w *countWriter) Write(p []byte) (int, error) {
+	*w += countWriter(len(p))
+	return len(p), nil
+}
+
+// progressReader is io.Reader which reports the number of read bytes.
+type progressReader struct {
+	io.Reader
+	written  int64
+	total    int64
+	progress func(written, total int64)
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if n > 0 {
+		r.written += int64(n)
+		r.progress(r.written, r.total)
+	}
+	return n, err
+}
+
+// contextReader is io.Reader which stops reading when the context is done.
+type contextReader struct {
+	ctx context.Context
+	io.Reader
+}
+
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.Reader.Read(p)
+}
+
+// contextReadCloser is io.ReadCloser which stops reading when the context is done.
+type contextReadCloser struct {
+	ctx context.Context
+	io.ReadCloser
+}
+
+func (r *contextReadCloser) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.ReadCloser.Read(p)
+}
+
 // Create new parameter for request.
 func newRequestParams() *requestParams {
 	return &requestParams{&url.Values{}}
@@ -303,8 +809,9 @@ func newRequestParams() *requestParams {
 // Creates new Response.
 func newResponse(resp *http.Response) *response {
 	r := &response{
-		Response: resp,
-		Error:    &APIResponseError{},
+		Response:  resp,
+		Error:     &APIResponseError{},
+		RateLimit: parseRateLimit(resp.Header),
 	}
 
 	return r
@@ -325,5 +832,10 @@ func checkResponseError(r *response) (*response, error) {
 		return nil, err
 	}
 
+	r.Error.StatusCode = r.StatusCode
+	if r.Request != nil && r.Request.URL != nil {
+		r.Error.Path = r.Request.URL.Path
+	}
+
 	return nil, r.Error
 }
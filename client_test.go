@@ -2,8 +2,10 @@ package backlog_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -376,7 +378,7 @@ func TestClient_NewReqest(t *testing.T) {
 	for n, tc := range cases {
 		tc := tc
 		t.Run(n, func(t *testing.T) {
-			request, err := backlog.ExportClientNewReqest(c, tc.method, tc.spath, tc.params, tc.body)
+			request, err := backlog.ExportClientNewReqest(c, context.Background(), tc.method, tc.spath, tc.params, tc.body)
 
 			switch {
 			case tc.wantError:
@@ -391,6 +393,24 @@ func TestClient_NewReqest(t *testing.T) {
 
 }
 
+func TestClient_NewReqest_nilContext(t *testing.T) {
+	c, _ := backlog.NewClient("https://test.backlog.com", "test")
+
+	request, err := backlog.ExportClientNewReqest(c, nil, http.MethodGet, "test", nil, nil)
+	assert.Error(t, err)
+	assert.Nil(t, request)
+}
+
+func TestClient_NewReqest_context(t *testing.T) {
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+	c, _ := backlog.NewClient("https://test.backlog.com", "test")
+
+	request, err := backlog.ExportClientNewReqest(c, ctx, http.MethodGet, "test", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "value", request.Context().Value(ctxKey{}))
+}
+
 func TestClient_Do(t *testing.T) {
 	c, _ := backlog.NewClient("https://test.backlog.com", "test")
 
@@ -436,8 +456,7 @@ func TestClient_Do(t *testing.T) {
 	})
 	c.ExportSetHTTPClient(httpClient)
 
-	req, _ := backlog.ExportClientNewReqest(
-		c, http.MethodGet, "test",
+	req, _ := backlog.ExportClientNewReqest(c, context.Background(), http.MethodGet, "test",
 		backlog.ExportNewRequestParams(),
 		bytes.NewReader([]byte("test")),
 	)
@@ -465,8 +484,7 @@ func TestClient_Do_httpClientError(t *testing.T) {
 	})
 	c.ExportSetHTTPClient(httpClient)
 
-	req, _ := backlog.ExportClientNewReqest(
-		c, http.MethodGet, "test",
+	req, _ := backlog.ExportClientNewReqest(c, context.Background(), http.MethodGet, "test",
 		backlog.ExportNewRequestParams(),
 		bytes.NewReader([]byte("test")),
 	)
@@ -475,6 +493,30 @@ func TestClient_Do_httpClientError(t *testing.T) {
 
 }
 
+func TestClient_Do_canceledWhileDecoding(t *testing.T) {
+	c, _ := backlog.NewClient("https://test.backlog.com", "test")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	httpClient := NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+		resp := &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(`{"id":1}`)),
+		}
+		return resp, nil
+	})
+	c.ExportSetHTTPClient(httpClient)
+
+	req, _ := backlog.ExportClientNewReqest(c, ctx, http.MethodGet, "test", nil, nil)
+	res, err := backlog.ExportClientDo(c, req)
+	assert.NoError(t, err)
+	defer res.Body.Close()
+
+	cancel()
+	wiki := backlog.Wiki{}
+	err = json.NewDecoder(res.Body).Decode(&wiki)
+	assert.Equal(t, context.Canceled, err)
+}
+
 func TestClient_Do_errorResponse(t *testing.T) {
 	c, _ := backlog.NewClient("https://test.backlog.com", "test")
 
@@ -505,8 +547,7 @@ func TestClient_Do_errorResponse(t *testing.T) {
 	})
 	c.ExportSetHTTPClient(httpClient)
 
-	req, _ := backlog.ExportClientNewReqest(
-		c, http.MethodGet, "test",
+	req, _ := backlog.ExportClientNewReqest(c, context.Background(), http.MethodGet, "test",
 		backlog.ExportNewRequestParams(),
 		bytes.NewReader([]byte("test")),
 	)
@@ -539,7 +580,7 @@ func TestClient_Get(t *testing.T) {
 	})
 	c.ExportSetHTTPClient(httpClient)
 
-	res, _ := backlog.ExportClientGet(c, spath, nil)
+	res, _ := backlog.ExportClientGet(c, context.Background(), spath, nil)
 	statusCode := res.ExportGetHTTPResponse().StatusCode
 	assert.Equal(t, http.StatusOK, statusCode)
 }
@@ -547,7 +588,7 @@ func TestClient_Get(t *testing.T) {
 func TestClient_Get_newRequestError(t *testing.T) {
 	c, _ := backlog.NewClient("https://test.backlog.com", "test")
 
-	_, err := backlog.ExportClientGet(c, "", backlog.ExportNewRequestParams())
+	_, err := backlog.ExportClientGet(c, context.Background(), "", backlog.ExportNewRequestParams())
 	assert.Error(t, err)
 }
 
@@ -575,14 +616,14 @@ func TestClient_Post(t *testing.T) {
 	})
 	c.ExportSetHTTPClient(httpClient)
 
-	res, _ := backlog.ExportClientPost(c, spath, nil)
+	res, _ := backlog.ExportClientPost(c, context.Background(), spath, nil)
 	assert.Equal(t, http.StatusOK, res.ExportGetHTTPResponse().StatusCode)
 }
 
 func TestClient_Post_newRequestError(t *testing.T) {
 	c, _ := backlog.NewClient("https://test.backlog.com", "test")
 
-	_, err := backlog.ExportClientPost(c, "", backlog.ExportNewRequestParams())
+	_, err := backlog.ExportClientPost(c, context.Background(), "", backlog.ExportNewRequestParams())
 	assert.Error(t, err)
 }
 
@@ -617,7 +658,7 @@ func TestClient_Patch(t *testing.T) {
 	params := backlog.ExportNewRequestParams()
 	params.Set("key", "value")
 
-	res, _ := backlog.ExportClientPatch(c, spath, params)
+	res, _ := backlog.ExportClientPatch(c, context.Background(), spath, params)
 	assert.Equal(t, http.StatusOK, res.ExportGetHTTPResponse().StatusCode)
 }
 
@@ -634,14 +675,14 @@ func TestClient_Patch_emptyParams(t *testing.T) {
 	})
 	c.ExportSetHTTPClient(httpClient)
 
-	res, _ := backlog.ExportClientPatch(c, spath, nil)
+	res, _ := backlog.ExportClientPatch(c, context.Background(), spath, nil)
 	assert.Equal(t, http.StatusOK, res.ExportGetHTTPResponse().StatusCode)
 }
 
 func TestClient_Patch_newRequestError(t *testing.T) {
 	c, _ := backlog.NewClient("https://test.backlog.com", "test")
 
-	_, err := backlog.ExportClientPatch(c, "", backlog.ExportNewRequestParams())
+	_, err := backlog.ExportClientPatch(c, context.Background(), "", backlog.ExportNewRequestParams())
 	assert.Error(t, err)
 }
 
@@ -677,7 +718,7 @@ func TestClient_Delete(t *testing.T) {
 	params := backlog.ExportNewRequestParams()
 	params.Set("key", "value")
 
-	res, _ := backlog.ExportClientDelete(c, spath, params)
+	res, _ := backlog.ExportClientDelete(c, context.Background(), spath, params)
 	assert.Equal(t, http.StatusOK, res.ExportGetHTTPResponse().StatusCode)
 }
 
@@ -694,14 +735,120 @@ func TestClient_Delete_emptyParams(t *testing.T) {
 	})
 	c.ExportSetHTTPClient(httpClient)
 
-	res, _ := backlog.ExportClientDelete(c, spath, nil)
+	res, _ := backlog.ExportClientDelete(c, context.Background(), spath, nil)
 	assert.Equal(t, http.StatusOK, res.ExportGetHTTPResponse().StatusCode)
 }
 
 func TestClient_Delete_newRequestError(t *testing.T) {
 	c, _ := backlog.NewClient("https://test.backlog.com", "test")
 
-	_, err := backlog.ExportClientDelete(c, "", backlog.ExportNewRequestParams())
+	_, err := backlog.ExportClientDelete(c, context.Background(), "", backlog.ExportNewRequestParams())
+	assert.Error(t, err)
+}
+
+func TestClient_Put(t *testing.T) {
+	baseURL := "https://test.backlog.com"
+	apiKey := "apikey"
+	spath := "spath"
+	want := struct {
+		method      string
+		url         string
+		contentType string
+		body        string
+	}{
+		method:      http.MethodPut,
+		url:         baseURL + "/api/v2/" + spath + "?apiKey=" + apiKey,
+		contentType: "application/x-www-form-urlencoded",
+		body:        "key=value",
+	}
+
+	c, _ := backlog.NewClient(baseURL, apiKey)
+
+	httpClient := NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+		defer req.Body.Close()
+		assert.Equal(t, want.method, req.Method)
+		assert.Equal(t, want.url, req.URL.String())
+		assert.Equal(t, want.contentType, req.Header.Get("Content-Type"))
+		body, _ := ioutil.ReadAll(req.Body)
+		assert.Equal(t, want.body, string(body))
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})
+	c.ExportSetHTTPClient(httpClient)
+
+	params := backlog.ExportNewRequestParams()
+	params.Set("key", "value")
+
+	res, _ := backlog.ExportClientPut(c, context.Background(), spath, params)
+	assert.Equal(t, http.StatusOK, res.ExportGetHTTPResponse().StatusCode)
+}
+
+func TestClient_Put_emptyParams(t *testing.T) {
+	baseURL := "https://test.backlog.com"
+	apiKey := "apikey"
+	spath := "spath"
+
+	c, _ := backlog.NewClient(baseURL, apiKey)
+
+	httpClient := NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+		defer req.Body.Close()
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})
+	c.ExportSetHTTPClient(httpClient)
+
+	res, _ := backlog.ExportClientPut(c, context.Background(), spath, nil)
+	assert.Equal(t, http.StatusOK, res.ExportGetHTTPResponse().StatusCode)
+}
+
+func TestClient_Put_newRequestError(t *testing.T) {
+	c, _ := backlog.NewClient("https://test.backlog.com", "test")
+
+	_, err := backlog.ExportClientPut(c, context.Background(), "", backlog.ExportNewRequestParams())
+	assert.Error(t, err)
+}
+
+func TestClient_Download(t *testing.T) {
+	baseURL := "https://test.backlog.com"
+	apiKey := "apikey"
+	spath := "spath"
+
+	c, _ := backlog.NewClient(baseURL, apiKey)
+
+	httpClient := NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodGet, req.Method)
+		assert.Equal(t, baseURL+"/api/v2/"+spath+"?apiKey="+apiKey, req.URL.String())
+		assert.Equal(t, "*/*", req.Header.Get("Accept"))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader("binary")),
+		}, nil
+	})
+	c.ExportSetHTTPClient(httpClient)
+
+	res, err := backlog.ExportClientDownload(c, context.Background(), spath, nil)
+	if assert.NoError(t, err) {
+		defer res.Body.Close()
+		body, _ := ioutil.ReadAll(res.Body)
+		assert.Equal(t, "binary", string(body))
+	}
+}
+
+func TestClient_Download_errorResponse(t *testing.T) {
+	c := NewClientMock("https://test.backlog.com", "apikey", func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       ioutil.NopCloser(strings.NewReader(`{"errors":[{"message":"No such image.","code":6,"moreInfo":""}]}`)),
+		}, nil
+	})
+
+	res, err := backlog.ExportClientDownload(c, context.Background(), "spath", nil)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestClient_Download_newRequestError(t *testing.T) {
+	c, _ := backlog.NewClient("https://test.backlog.com", "test")
+
+	_, err := backlog.ExportClientDownload(c, context.Background(), "", nil)
 	assert.Error(t, err)
 }
 
@@ -734,30 +881,46 @@ func TestClient_Uploade(t *testing.T) {
 	})
 	c.ExportSetHTTPClient(httpClient)
 
-	res, err := backlog.ExportClientUploade(c, spath, fpath, "fname")
+	res, err := backlog.ExportClientUploade(c, context.Background(), spath, fpath, "fname")
 	assert.NoError(t, err)
 
 	assert.Equal(t, http.StatusOK, res.ExportGetHTTPResponse().StatusCode)
 }
 
+func TestClient_Uploade_canceled(t *testing.T) {
+	c, _ := backlog.NewClient("https://test.backlog.com", "test")
+
+	httpClient := NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+		t.Error("httpClient.Do must never be called")
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})
+	c.ExportSetHTTPClient(httpClient)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	res, err := backlog.ExportClientUploade(c, ctx, "spath", "testdata/testfile", "fname")
+	assert.Equal(t, context.Canceled, err)
+	assert.Nil(t, res)
+}
+
 func TestClient_Uploade_newRequestError(t *testing.T) {
 	c, _ := backlog.NewClient("https://test.backlog.com", "test")
 
-	_, err := backlog.ExportClientUploade(c, "", "testdata/testfile", "fname")
+	_, err := backlog.ExportClientUploade(c, context.Background(), "", "testdata/testfile", "fname")
 	assert.NotNil(t, err)
 }
 
 func TestClient_Uploade_emptyFilePath(t *testing.T) {
 	c, _ := backlog.NewClient("https://test.backlog.com", "test")
 
-	_, err := backlog.ExportClientUploade(c, "spath", "", "fname")
+	_, err := backlog.ExportClientUploade(c, context.Background(), "spath", "", "fname")
 	assert.Error(t, err, "file's path and name is required")
 }
 
 func TestClient_Uploade_emptyFileName(t *testing.T) {
 	c, _ := backlog.NewClient("https://test.backlog.com", "test")
 
-	_, err := backlog.ExportClientUploade(c, "spath", "fpath", "")
+	_, err := backlog.ExportClientUploade(c, context.Background(), "spath", "fpath", "")
 	assert.Error(t, err, "file's path and name is required")
 }
 
@@ -834,3 +997,235 @@ func TestCeckResponseError_invalidJSON(t *testing.T) {
 		assert.Equal(t, reflect.TypeOf(want), reflect.TypeOf(err))
 	}
 }
+
+type loggerMock struct {
+	logs []string
+}
+
+func (l *loggerMock) Printf(format string, v ...interface{}) {
+	l.logs = append(l.logs, fmt.Sprintf(format, v...))
+}
+
+func TestNewClient_options(t *testing.T) {
+	httpClient := &http.Client{}
+	transport := &http.Transport{}
+
+	cases := map[string]struct {
+		options   []backlog.ClientOption
+		wantError bool
+	}{
+		"http-client": {
+			options:   []backlog.ClientOption{backlog.WithHTTPClient(httpClient)},
+			wantError: false,
+		},
+		"http-client-nil": {
+			options:   []backlog.ClientOption{backlog.WithHTTPClient(nil)},
+			wantError: true,
+		},
+		"user-agent": {
+			options:   []backlog.ClientOption{backlog.WithUserAgent("test-agent")},
+			wantError: false,
+		},
+		"user-agent-empty": {
+			options:   []backlog.ClientOption{backlog.WithUserAgent("")},
+			wantError: true,
+		},
+		"timeout": {
+			options:   []backlog.ClientOption{backlog.WithTimeout(time.Second)},
+			wantError: false,
+		},
+		"timeout-negative": {
+			options:   []backlog.ClientOption{backlog.WithTimeout(-time.Second)},
+			wantError: true,
+		},
+		"base-transport": {
+			options:   []backlog.ClientOption{backlog.WithBaseTransport(transport)},
+			wantError: false,
+		},
+		"base-transport-nil": {
+			options:   []backlog.ClientOption{backlog.WithBaseTransport(nil)},
+			wantError: true,
+		},
+		"debug-logger": {
+			options:   []backlog.ClientOption{backlog.WithDebugLogger(&loggerMock{})},
+			wantError: false,
+		},
+		"debug-logger-nil": {
+			options:   []backlog.ClientOption{backlog.WithDebugLogger(nil)},
+			wantError: true,
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			c, err := backlog.NewClient("https://test.backlog.com", "test", tc.options...)
+
+			if tc.wantError {
+				assert.Error(t, err)
+				assert.Nil(t, c)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, c)
+			}
+		})
+	}
+}
+
+func TestNewClient_withHTTPClient(t *testing.T) {
+	httpClient := &http.Client{}
+	c, _ := backlog.NewClient("https://test.backlog.com", "test", backlog.WithHTTPClient(httpClient))
+
+	assert.Equal(t, httpClient, c.ExportHTTPClient())
+}
+
+func TestNewClient_withTimeoutAndTransport(t *testing.T) {
+	httpClient := &http.Client{}
+	transport := &http.Transport{}
+	c, _ := backlog.NewClient(
+		"https://test.backlog.com", "test",
+		backlog.WithHTTPClient(httpClient),
+		backlog.WithTimeout(time.Second),
+		backlog.WithBaseTransport(transport),
+	)
+
+	assert.Equal(t, time.Second, c.ExportHTTPClient().Timeout)
+	assert.Equal(t, transport, c.ExportHTTPClient().Transport)
+	// The given client and the default client are not modified.
+	assert.Zero(t, httpClient.Timeout)
+	assert.Nil(t, httpClient.Transport)
+	assert.Zero(t, http.DefaultClient.Timeout)
+}
+
+func TestNewClient_withTimeoutAndTransport_beforeHTTPClient(t *testing.T) {
+	httpClient := &http.Client{}
+	transport := &http.Transport{}
+	c, _ := backlog.NewClient(
+		"https://test.backlog.com", "test",
+		backlog.WithTimeout(time.Second),
+		backlog.WithBaseTransport(transport),
+		backlog.WithHTTPClient(httpClient),
+	)
+
+	assert.Equal(t, time.Second, c.ExportHTTPClient().Timeout)
+	assert.Equal(t, transport, c.ExportHTTPClient().Transport)
+	assert.Zero(t, httpClient.Timeout)
+	assert.Nil(t, httpClient.Transport)
+}
+
+func TestNewClient_withUserAgent(t *testing.T) {
+	c := NewClientMock("https://test.backlog.com", "test", func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "test-agent", req.Header.Get("User-Agent"))
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})
+	backlog.WithUserAgent("test-agent")(c)
+
+	_, err := backlog.ExportClientGet(c, context.Background(), "spath", nil)
+	assert.NoError(t, err)
+}
+
+func TestNewClient_withDebugLogger(t *testing.T) {
+	logger := &loggerMock{}
+	c, _ := backlog.NewClient(
+		"https://test.backlog.com", "secret",
+		backlog.WithDebugLogger(logger),
+		backlog.WithHTTPClient(NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+			if req.URL.Path == "/api/v2/error" {
+				return nil, errors.New("connection refused")
+			}
+			return &http.Response{StatusCode: http.StatusOK, Status: "200 OK"}, nil
+		})),
+	)
+
+	backlog.ExportClientGet(c, context.Background(), "spath", nil)
+	backlog.ExportClientGet(c, context.Background(), "error", nil)
+
+	assert.Len(t, logger.logs, 2)
+	assert.Contains(t, logger.logs[0], "GET /api/v2/spath: 200 OK")
+	assert.Contains(t, logger.logs[1], "GET /api/v2/error:")
+	assert.Contains(t, logger.logs[1], "connection refused")
+	for _, l := range logger.logs {
+		assert.NotContains(t, l, "secret")
+	}
+}
+
+func TestClient_Do_redactAPIKey(t *testing.T) {
+	apiKey := "0123456789abcdefghijklmnopqrstuvwxyz"
+
+	cases := map[string]struct {
+		err     error
+		wantMsg string
+	}{
+		"transport-error": {
+			err:     errors.New("connection refused"),
+			wantMsg: "connection refused",
+		},
+		"error-contains-api-key": {
+			err:     errors.New("bad key " + apiKey),
+			wantMsg: "bad key REDACTED",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			c, _ := backlog.NewClient(
+				"https://test.backlog.com", apiKey,
+				backlog.WithHTTPClient(NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+					assert.Equal(t, apiKey, req.URL.Query().Get("apiKey"))
+					return nil, tc.err
+				})),
+			)
+
+			_, err := backlog.ExportClientGet(c, context.Background(), "spath", nil)
+			assert.Error(t, err)
+			assert.NotContains(t, err.Error(), apiKey)
+			assert.Contains(t, err.Error(), "apiKey=REDACTED")
+			assert.Contains(t, err.Error(), tc.wantMsg)
+		})
+	}
+}
+
+func TestClient_Do_redactMiddlewareError(t *testing.T) {
+	apiKey := "0123456789abcdefghijklmnopqrstuvwxyz"
+	c, _ := backlog.NewClient(
+		"https://test.backlog.com", apiKey,
+		backlog.WithMiddleware(func(next backlog.RoundTrip) backlog.RoundTrip {
+			return func(req *http.Request) (*http.Response, error) {
+				return nil, fmt.Errorf("denied: %s", req.URL)
+			}
+		}),
+	)
+
+	_, err := backlog.ExportClientGet(c, context.Background(), "spath", nil)
+	assert.EqualError(t, err, "denied: https://test.backlog.com/api/v2/spath?apiKey=REDACTED")
+}
+
+func TestClient_Do_canceledKeepsCause(t *testing.T) {
+	c, _ := backlog.NewClient(
+		"https://test.backlog.com", "0123456789abcdefghijklmnopqrstuvwxyz",
+		backlog.WithHTTPClient(NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+			return nil, req.Context().Err()
+		})),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := backlog.ExportClientGet(c, ctx, "spath", nil)
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
+func TestClient_String(t *testing.T) {
+	apiKey := "0123456789abcdefghijklmnopqrstuvwxyz"
+	c, _ := backlog.NewClient("https://test.backlog.com", apiKey)
+
+	for _, format := range []string{"%s", "%v", "%+v", "%#v"} {
+		s := fmt.Sprintf(format, c)
+		assert.NotContains(t, s, apiKey, format)
+		assert.Contains(t, s, "https://test.backlog.com", format)
+	}
+
+	ts := backlog.StaticTokenSource(&backlog.Token{AccessToken: apiKey})
+	c, _ = backlog.NewClientWithOAuth2("https://test.backlog.com", ts)
+	assert.Equal(t, "backlog.Client{url: https://test.backlog.com, auth: oauth2}", c.String())
+}
@@ -0,0 +1,319 @@
+package backlog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+func decodeComment(resp *response) (*Comment, error) {
+	defer resp.Body.Close()
+
+	v := Comment{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+func getCommentList(ctx context.Context, get clientGet, spath string, options ...CommentListOption) ([]*Comment, error) {
+	params := newRequestParams()
+	for _, option := range options {
+		if err := option(params); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := get(ctx, spath, params)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := []*Comment{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+func countComments(ctx context.Context, get clientGet, spath string) (int, error) {
+	resp, err := get(ctx, spath, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	v := map[string]int{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return 0, err
+	}
+
+	return v["count"], nil
+}
+
+func getComment(ctx context.Context, get clientGet, spath string) (*Comment, error) {
+	resp, err := get(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeComment(resp)
+}
+
+func addComment(ctx context.Context, post clientPost, spath, content string, options ...CommentOption) (*Comment, error) {
+	if content == "" {
+		return nil, errors.New("content is requierd")
+	}
+
+	params := newRequestParams()
+	params.Set("content", content)
+	for _, option := range options {
+		if err := option(params); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := post(ctx, spath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeComment(resp)
+}
+
+func updateComment(ctx context.Context, patch clientPatch, spath, content string) (*Comment, error) {
+	if content == "" {
+		return nil, errors.New("content is requierd")
+	}
+
+	params := newRequestParams()
+	params.Set("content", content)
+
+	resp, err := patch(ctx, spath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeComment(resp)
+}
+
+func deleteComment(ctx context.Context, delete clientDelete, spath string) (*Comment, error) {
+	resp, err := delete(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeComment(resp)
+}
+
+func getCommentNotifications(ctx context.Context, get clientGet, spath string) ([]*Notification, error) {
+	resp, err := get(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := []*Notification{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+func notifyComment(ctx context.Context, post clientPost, spath string, userIDs []int) (*Comment, error) {
+	if len(userIDs) == 0 {
+		return nil, errors.New("userIDs must not be empty")
+	}
+
+	params := newRequestParams()
+	if err := withNotifiedUserIDs(userIDs)(params); err != nil {
+		return nil, err
+	}
+
+	resp, err := post(ctx, spath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeComment(resp)
+}
+
+// IssueCommentService has methods for comments of issue.
+type IssueCommentService struct {
+	method *method
+
+	Option *CommentOptionService
+}
+
+func issueCommentPath(issueIDOrKey string) (string, error) {
+	if issueIDOrKey == "" {
+		return "", errors.New("issueIDOrKey must not be empty")
+	}
+	return "issues/" + issueIDOrKey + "/comments", nil
+}
+
+// List returns a list of comments in the issue.
+//
+// This method supports options returned by methods in "*Client.Issue.Comment.Option".
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-comment-list
+func (s *IssueCommentService) List(issueIDOrKey string, options ...CommentListOption) ([]*Comment, error) {
+	return s.ListContext(context.Background(), issueIDOrKey, options...)
+}
+
+// ListContext is like List but with the context.
+func (s *IssueCommentService) ListContext(ctx context.Context, issueIDOrKey string, options ...CommentListOption) ([]*Comment, error) {
+	spath, err := issueCommentPath(issueIDOrKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return getCommentList(ctx, s.method.Get, spath, options...)
+}
+
+// Count returns the number of comments in the issue.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/count-comment
+func (s *IssueCommentService) Count(issueIDOrKey string) (int, error) {
+	return s.CountContext(context.Background(), issueIDOrKey)
+}
+
+// CountContext is like Count but with the context.
+func (s *IssueCommentService) CountContext(ctx context.Context, issueIDOrKey string) (int, error) {
+	spath, err := issueCommentPath(issueIDOrKey)
+	if err != nil {
+		return 0, err
+	}
+
+	return countComments(ctx, s.method.Get, spath+"/count")
+}
+
+// One returns one of the comments in the issue by ID.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-comment
+func (s *IssueCommentService) One(issueIDOrKey string, commentID int) (*Comment, error) {
+	return s.OneContext(context.Background(), issueIDOrKey, commentID)
+}
+
+// OneContext is like One but with the context.
+func (s *IssueCommentService) OneContext(ctx context.Context, issueIDOrKey string, commentID int) (*Comment, error) {
+	if commentID < 1 {
+		return nil, fmt.Errorf("commentID must be 1 or more: %d", commentID)
+	}
+	spath, err := issueCommentPath(issueIDOrKey)
+	if err != nil {
+		return nil, err
+	}
+	spath += "/" + strconv.Itoa(commentID)
+
+	return getComment(ctx, s.method.Get, spath)
+}
+
+// Add adds a comment to the issue.
+//
+// This method supports options returned by methods in "*Client.Issue.Comment.Option".
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-comment
+func (s *IssueCommentService) Add(issueIDOrKey, content string, options ...CommentOption) (*Comment, error) {
+	return s.AddContext(context.Background(), issueIDOrKey, content, options...)
+}
+
+// AddContext is like Add but with the context.
+func (s *IssueCommentService) AddContext(ctx context.Context, issueIDOrKey, content string, options ...CommentOption) (*Comment, error) {
+	spath, err := issueCommentPath(issueIDOrKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return addComment(ctx, s.method.Post, spath, content, options...)
+}
+
+// Update updates content of the comment in the issue.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-comment
+func (s *IssueCommentService) Update(issueIDOrKey string, commentID int, content string) (*Comment, error) {
+	return s.UpdateContext(context.Background(), issueIDOrKey, commentID, content)
+}
+
+// UpdateContext is like Update but with the context.
+func (s *IssueCommentService) UpdateContext(ctx context.Context, issueIDOrKey string, commentID int, content string) (*Comment, error) {
+	if commentID < 1 {
+		return nil, fmt.Errorf("commentID must be 1 or more: %d", commentID)
+	}
+	spath, err := issueCommentPath(issueIDOrKey)
+	if err != nil {
+		return nil, err
+	}
+	spath += "/" + strconv.Itoa(commentID)
+
+	return updateComment(ctx, s.method.Patch, spath, content)
+}
+
+// Delete deletes the comment in the issue.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-comment
+func (s *IssueCommentService) Delete(issueIDOrKey string, commentID int) (*Comment, error) {
+	return s.DeleteContext(context.Background(), issueIDOrKey, commentID)
+}
+
+// DeleteContext is like Delete but with the context.
+func (s *IssueCommentService) DeleteContext(ctx context.Context, issueIDOrKey string, commentID int) (*Comment, error) {
+	if commentID < 1 {
+		return nil, fmt.Errorf("commentID must be 1 or more: %d", commentID)
+	}
+	spath, err := issueCommentPath(issueIDOrKey)
+	if err != nil {
+		return nil, err
+	}
+	spath += "/" + strconv.Itoa(commentID)
+
+	return deleteComment(ctx, s.method.Delete, spath)
+}
+
+// Notifications returns a list of notifications of the comment in the issue.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-list-of-comment-notifications
+func (s *IssueCommentService) Notifications(issueIDOrKey string, commentID int) ([]*Notification, error) {
+	return s.NotificationsContext(context.Background(), issueIDOrKey, commentID)
+}
+
+// NotificationsContext is like Notifications but with the context.
+func (s *IssueCommentService) NotificationsContext(ctx context.Context, issueIDOrKey string, commentID int) ([]*Notification, error) {
+	if commentID < 1 {
+		return nil, fmt.Errorf("commentID must be 1 or more: %d", commentID)
+	}
+	spath, err := issueCommentPath(issueIDOrKey)
+	if err != nil {
+		return nil, err
+	}
+	spath += "/" + strconv.Itoa(commentID)
+
+	return getCommentNotifications(ctx, s.method.Get, spath+"/notifications")
+}
+
+// Notify notifies users of the comment in the issue.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-comment-notification
+func (s *IssueCommentService) Notify(issueIDOrKey string, commentID int, userIDs []int) (*Comment, error) {
+	return s.NotifyContext(context.Background(), issueIDOrKey, commentID, userIDs)
+}
+
+// NotifyContext is like Notify but with the context.
+func (s *IssueCommentService) NotifyContext(ctx context.Context, issueIDOrKey string, commentID int, userIDs []int) (*Comment, error) {
+	if commentID < 1 {
+		return nil, fmt.Errorf("commentID must be 1 or more: %d", commentID)
+	}
+	spath, err := issueCommentPath(issueIDOrKey)
+	if err != nil {
+		return nil, err
+	}
+	spath += "/" + strconv.Itoa(commentID)
+
+	return notifyComment(ctx, s.method.Post, spath+"/notifications", userIDs)
+}
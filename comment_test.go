@@ -0,0 +1,421 @@
+package backlog_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueCommentService_List(t *testing.T) {
+	bj, err := os.Open("testdata/json/comment_list.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	want := struct {
+		spath string
+		minID string
+		count string
+		order string
+	}{
+		spath: "issues/BLG-1/comments",
+		minID: "5",
+		count: "20",
+		order: "desc",
+	}
+	s := &backlog.IssueCommentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, want.spath, spath)
+			assert.Equal(t, want.minID, params.Get("minId"))
+			assert.Equal(t, want.count, params.Get("count"))
+			assert.Equal(t, want.order, params.Get("order"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	o := s.Option
+	comments, err := s.List("BLG-1", o.WithMinID(5), o.WithCount(20), o.WithOrder(backlog.OrderDesc))
+	assert.NoError(t, err)
+	assert.Len(t, comments, 2)
+	assert.Equal(t, 7, comments[0].ID)
+	assert.Nil(t, comments[0].ChangeLogs)
+	assert.Equal(t, "status", comments[1].ChangeLogs[0].Field)
+}
+
+func TestIssueCommentService_List_param_error(t *testing.T) {
+	s := &backlog.IssueCommentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Get must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	comments, err := s.List("")
+	assert.Nil(t, comments)
+	assert.Error(t, err)
+
+	comments, err = s.List("BLG-1", s.Option.WithCount(0))
+	assert.Nil(t, comments)
+	assert.Error(t, err)
+}
+
+func TestIssueCommentService_List_invaliedJson(t *testing.T) {
+	bj, err := os.Open("testdata/json/invalied.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.IssueCommentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	comments, err := s.List("BLG-1")
+	assert.Nil(t, comments)
+	assert.Error(t, err)
+}
+
+func TestIssueCommentService_Count(t *testing.T) {
+	body := ioutil.NopCloser(strings.NewReader(`{"count":10}`))
+	s := &backlog.IssueCommentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "issues/BLG-1/comments/count", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       body,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	count, err := s.Count("BLG-1")
+	assert.NoError(t, err)
+	assert.Equal(t, 10, count)
+}
+
+func TestIssueCommentService_Count_clientError(t *testing.T) {
+	s := &backlog.IssueCommentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			return nil, errors.New("error")
+		},
+	})
+	count, err := s.Count("BLG-1")
+	assert.Zero(t, count)
+	assert.Error(t, err)
+}
+
+func TestIssueCommentService_One(t *testing.T) {
+	bj, err := os.Open("testdata/json/comment.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.IssueCommentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "issues/BLG-1/comments/6", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	comment, err := s.One("BLG-1", 6)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, comment.ID)
+	assert.Equal(t, "admin", comment.CreatedUser.UserID)
+	assert.Len(t, comment.ChangeLogs, 3)
+
+	status := comment.ChangeLogs[0]
+	assert.Equal(t, "2", status.NewValue)
+	assert.Equal(t, "1", status.OriginalValue)
+	assert.Nil(t, status.AttachmentInfo)
+
+	attachment := comment.ChangeLogs[1]
+	assert.Equal(t, "", attachment.OriginalValue)
+	assert.Equal(t, 12, attachment.AttachmentInfo.ID)
+	assert.Equal(t, "screenshot.png", attachment.AttachmentInfo.Name)
+
+	customField := comment.ChangeLogs[2]
+	assert.Equal(t, 3, customField.AttributeInfo.ID)
+	assert.Equal(t, 5, customField.AttributeInfo.TypeID)
+	assert.Equal(t, "issue.create", customField.NotificationInfo.Type)
+}
+
+func TestIssueCommentService_param_error(t *testing.T) {
+	s := &backlog.IssueCommentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Get must never be called")
+			return nil, errors.New("error")
+		},
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Post must never be called")
+			return nil, errors.New("error")
+		},
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Patch must never be called")
+			return nil, errors.New("error")
+		},
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Delete must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	cases := map[string]struct {
+		issueIDOrKey string
+		commentID    int
+	}{
+		"issueIDOrKey_empty": {
+			issueIDOrKey: "",
+			commentID:    1,
+		},
+		"commentID_zero": {
+			issueIDOrKey: "BLG-1",
+			commentID:    0,
+		},
+		"commentID_negative": {
+			issueIDOrKey: "BLG-1",
+			commentID:    -1,
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			_, err := s.One(tc.issueIDOrKey, tc.commentID)
+			assert.Error(t, err)
+			_, err = s.Update(tc.issueIDOrKey, tc.commentID, "content")
+			assert.Error(t, err)
+			_, err = s.Delete(tc.issueIDOrKey, tc.commentID)
+			assert.Error(t, err)
+			_, err = s.Notifications(tc.issueIDOrKey, tc.commentID)
+			assert.Error(t, err)
+			_, err = s.Notify(tc.issueIDOrKey, tc.commentID, []int{1})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestIssueCommentService_Add(t *testing.T) {
+	bj, err := os.Open("testdata/json/comment.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	want := struct {
+		spath          string
+		content        string
+		notifiedUserID []string
+		attachmentID   []string
+	}{
+		spath:          "issues/BLG-1/comments",
+		content:        "test",
+		notifiedUserID: []string{"1", "2"},
+		attachmentID:   []string{"12"},
+	}
+	s := &backlog.IssueCommentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, want.spath, spath)
+			assert.Equal(t, want.content, params.Get("content"))
+			v := *params.ExportURLValues()
+			assert.Equal(t, want.notifiedUserID, v["notifiedUserId[]"])
+			assert.Equal(t, want.attachmentID, v["attachmentId[]"])
+
+			resp := &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	o := s.Option
+	comment, err := s.Add("BLG-1", "test", o.WithNotifiedUserIDs([]int{1, 2}), o.WithAttachmentIDs([]int{12}))
+	assert.NoError(t, err)
+	assert.Equal(t, want.content, comment.Content)
+}
+
+func TestIssueCommentService_Add_param_error(t *testing.T) {
+	s := &backlog.IssueCommentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Post must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	comment, err := s.Add("", "test")
+	assert.Nil(t, comment)
+	assert.Error(t, err)
+
+	comment, err = s.Add("BLG-1", "")
+	assert.Nil(t, comment)
+	assert.Error(t, err)
+
+	comment, err = s.Add("BLG-1", "test", s.Option.WithAttachmentIDs([]int{0}))
+	assert.Nil(t, comment)
+	assert.Error(t, err)
+}
+
+func TestIssueCommentService_Update(t *testing.T) {
+	bj, err := os.Open("testdata/json/comment.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.IssueCommentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "issues/BLG-1/comments/6", spath)
+			assert.Equal(t, "test", params.Get("content"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	comment, err := s.Update("BLG-1", 6, "test")
+	assert.NoError(t, err)
+	assert.Equal(t, 6, comment.ID)
+
+	comment, err = s.Update("BLG-1", 6, "")
+	assert.Nil(t, comment)
+	assert.Error(t, err)
+}
+
+func TestIssueCommentService_Delete(t *testing.T) {
+	bj, err := os.Open("testdata/json/comment.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.IssueCommentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "issues/BLG-1/comments/6", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	comment, err := s.Delete("BLG-1", 6)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, comment.ID)
+}
+
+func TestIssueCommentService_Delete_clientError(t *testing.T) {
+	s := &backlog.IssueCommentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			return nil, errors.New("error")
+		},
+	})
+	comment, err := s.Delete("BLG-1", 6)
+	assert.Nil(t, comment)
+	assert.Error(t, err)
+}
+
+func TestIssueCommentService_Notifications(t *testing.T) {
+	bj, err := os.Open("testdata/json/comment_notification_list.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.IssueCommentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "issues/BLG-1/comments/6/notifications", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	notifications, err := s.Notifications("BLG-1", 6)
+	assert.NoError(t, err)
+	assert.Len(t, notifications, 1)
+	assert.Equal(t, 22, notifications[0].ID)
+	assert.Equal(t, 2, notifications[0].Reason)
+	assert.Equal(t, "takada", notifications[0].User.UserID)
+}
+
+func TestIssueCommentService_Notify(t *testing.T) {
+	bj, err := os.Open("testdata/json/comment.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.IssueCommentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "issues/BLG-1/comments/6/notifications", spath)
+			v := *params.ExportURLValues()
+			assert.Equal(t, []string{"5686"}, v["notifiedUserId[]"])
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	comment, err := s.Notify("BLG-1", 6, []int{5686})
+	assert.NoError(t, err)
+	assert.Equal(t, 6, comment.ID)
+}
+
+func TestIssueCommentService_Notify_param_error(t *testing.T) {
+	s := &backlog.IssueCommentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Post must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	comment, err := s.Notify("BLG-1", 6, nil)
+	assert.Nil(t, comment)
+	assert.Error(t, err)
+
+	comment, err = s.Notify("BLG-1", 6, []int{0})
+	assert.Nil(t, comment)
+	assert.Error(t, err)
+}
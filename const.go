@@ -12,6 +12,77 @@ const (
 	FormatBacklog  format = "backlog"
 )
 
+// Type ID of custom field
+const (
+	CustomFieldTypeText         = 1
+	CustomFieldTypeSentence     = 2
+	CustomFieldTypeNumber       = 3
+	CustomFieldTypeDate         = 4
+	CustomFieldTypeSingleList   = 5
+	CustomFieldTypeMultipleList = 6
+	CustomFieldTypeCheckBox     = 7
+	CustomFieldTypeRadio        = 8
+)
+
+// Default status ID of issues
+const (
+	StatusOpen       = 1
+	StatusInProgress = 2
+	StatusResolved   = 3
+	StatusClosed     = 4
+)
+
+// Status ID of pull requests
+const (
+	PullRequestStatusOpen   = 1
+	PullRequestStatusClosed = 2
+	PullRequestStatusMerged = 3
+)
+
+// Colors which can be set to statuses
+var statusColors = []string{
+	"#ea2c00", "#e87758", "#e07b9a", "#868cb7", "#3b9dbd",
+	"#4caf93", "#b0be3c", "#eda62a", "#f42858", "#393939",
+}
+
+// Colors which can be set to issue types
+var issueTypeColors = []string{
+	"#e30000", "#990000", "#934981", "#814fbc", "#2779ca",
+	"#007e9a", "#7ea800", "#ff9200", "#ff3265", "#666665",
+}
+
+// Sort key of issues
+const (
+	SortIssueType      issueSort = "issueType"
+	SortCategory       issueSort = "category"
+	SortVersion        issueSort = "version"
+	SortMilestone      issueSort = "milestone"
+	SortSummary        issueSort = "summary"
+	SortStatus         issueSort = "status"
+	SortPriority       issueSort = "priority"
+	SortAttachment     issueSort = "attachment"
+	SortSharedFile     issueSort = "sharedFile"
+	SortCreated        issueSort = "created"
+	SortCreatedUser    issueSort = "createdUser"
+	SortUpdated        issueSort = "updated"
+	SortUpdatedUser    issueSort = "updatedUser"
+	SortAssignee       issueSort = "assignee"
+	SortStartDate      issueSort = "startDate"
+	SortDueDate        issueSort = "dueDate"
+	SortEstimatedHours issueSort = "estimatedHours"
+	SortActualHours    issueSort = "actualHours"
+	SortChildIssue     issueSort = "childIssue"
+)
+
+// Parent-child relationship of issues
+const (
+	ParentChildAll parentChild = iota
+	ParentChildExcludeChild
+	ParentChildChild
+	ParentChildNeither
+	ParentChildParent
+)
+
 // Role type
 const (
 	_ role = iota
@@ -22,3 +93,21 @@ const (
 	RoleGuestReporter
 	RoleGuestViewer
 )
+
+// Error code of Backlog API
+const (
+	_ ErrorCode = iota
+	InternalError
+	LicenceError
+	LicenceExpiredError
+	AccessDeniedError
+	UnauthorizedOperationError
+	NoResourceError
+	InvalidRequestError
+	SpaceOverCapacityError
+	ResourceOverflowError
+	TooLargeFileError
+	AuthenticationError
+	RequiredMFAError
+	TooManyRequestsError
+)
@@ -1,6 +1,425 @@
 package backlog
 
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+func decodeCustomField(resp *response) (*CustomField, error) {
+	defer resp.Body.Close()
+
+	v := CustomField{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+func customFieldPath(target ProjectIDOrKeyGetter, customFieldID int) (string, error) {
+	if customFieldID < 1 {
+		return "", fmt.Errorf("customFieldID must be 1 or more: %d", customFieldID)
+	}
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return "", err
+	}
+	return "projects/" + projectIDOrKey + "/customFields/" + strconv.Itoa(customFieldID), nil
+}
+
 // CustomFieldService has methods for CustomField.
 type CustomFieldService struct {
 	method *method
+
+	Option *CustomFieldOptionService
+}
+
+// All returns a list of custom fields in the project.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-custom-field-list
+func (s *CustomFieldService) All(target ProjectIDOrKeyGetter) ([]*CustomField, error) {
+	return s.AllContext(context.Background(), target)
+}
+
+// AllContext is like All but with the context.
+func (s *CustomFieldService) AllContext(ctx context.Context, target ProjectIDOrKeyGetter) ([]*CustomField, error) {
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return nil, err
+	}
+	spath := "projects/" + projectIDOrKey + "/customFields"
+
+	resp, err := s.method.Get(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := []*CustomField{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// Create creates a new custom field in the project.
+// typeID is one of the CustomFieldType constants.
+//
+// This method supports options returned by methods in "*Client.Project.CustomField.Option".
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-custom-field
+func (s *CustomFieldService) Create(target ProjectIDOrKeyGetter, typeID int, name string, options ...CustomFieldOption) (*CustomField, error) {
+	return s.CreateContext(context.Background(), target, typeID, name, options...)
+}
+
+// CreateContext is like Create but with the context.
+func (s *CustomFieldService) CreateContext(ctx context.Context, target ProjectIDOrKeyGetter, typeID int, name string, options ...CustomFieldOption) (*CustomField, error) {
+	if typeID < CustomFieldTypeText || CustomFieldTypeRadio < typeID {
+		return nil, fmt.Errorf("typeID must be between %d and %d: %d", CustomFieldTypeText, CustomFieldTypeRadio, typeID)
+	}
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return nil, err
+	}
+	spath := "projects/" + projectIDOrKey + "/customFields"
+
+	params := newRequestParams()
+	params.Set("typeId", strconv.Itoa(typeID))
+	if err := withName(name)(params); err != nil {
+		return nil, err
+	}
+	for _, option := range options {
+		if err := option(params); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.method.Post(ctx, spath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeCustomField(resp)
+}
+
+// Update updates the custom field in the project.
+//
+// This method supports options returned by methods in "*Client.Project.CustomField.Option".
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-custom-field
+func (s *CustomFieldService) Update(target ProjectIDOrKeyGetter, customFieldID int, options ...CustomFieldOption) (*CustomField, error) {
+	return s.UpdateContext(context.Background(), target, customFieldID, options...)
+}
+
+// UpdateContext is like Update but with the context.
+func (s *CustomFieldService) UpdateContext(ctx context.Context, target ProjectIDOrKeyGetter, customFieldID int, options ...CustomFieldOption) (*CustomField, error) {
+	spath, err := customFieldPath(target, customFieldID)
+	if err != nil {
+		return nil, err
+	}
+	if options == nil {
+		return nil, errors.New("requires one or more options")
+	}
+
+	params := newRequestParams()
+	for _, option := range options {
+		if err := option(params); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.method.Patch(ctx, spath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeCustomField(resp)
+}
+
+// Delete deletes the custom field in the project.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-custom-field
+func (s *CustomFieldService) Delete(target ProjectIDOrKeyGetter, customFieldID int) (*CustomField, error) {
+	return s.DeleteContext(context.Background(), target, customFieldID)
+}
+
+// DeleteContext is like Delete but with the context.
+func (s *CustomFieldService) DeleteContext(ctx context.Context, target ProjectIDOrKeyGetter, customFieldID int) (*CustomField, error) {
+	spath, err := customFieldPath(target, customFieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.method.Delete(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeCustomField(resp)
+}
+
+// AddItem adds an item to the list type custom field.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-list-item-for-list-type-custom-field
+func (s *CustomFieldService) AddItem(target ProjectIDOrKeyGetter, customFieldID int, name string) (*CustomField, error) {
+	return s.AddItemContext(context.Background(), target, customFieldID, name)
+}
+
+// AddItemContext is like AddItem but with the context.
+func (s *CustomFieldService) AddItemContext(ctx context.Context, target ProjectIDOrKeyGetter, customFieldID int, name string) (*CustomField, error) {
+	spath, err := customFieldPath(target, customFieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	params := newRequestParams()
+	if err := withName(name)(params); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.method.Post(ctx, spath+"/items", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeCustomField(resp)
+}
+
+// UpdateItem renames the item of the list type custom field.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-list-item-for-list-type-custom-field
+func (s *CustomFieldService) UpdateItem(target ProjectIDOrKeyGetter, customFieldID, itemID int, name string) (*CustomField, error) {
+	return s.UpdateItemContext(context.Background(), target, customFieldID, itemID, name)
+}
+
+// UpdateItemContext is like UpdateItem but with the context.
+func (s *CustomFieldService) UpdateItemContext(ctx context.Context, target ProjectIDOrKeyGetter, customFieldID, itemID int, name string) (*CustomField, error) {
+	spath, err := customFieldPath(target, customFieldID)
+	if err != nil {
+		return nil, err
+	}
+	if itemID < 1 {
+		return nil, fmt.Errorf("itemID must be 1 or more: %d", itemID)
+	}
+
+	params := newRequestParams()
+	if err := withName(name)(params); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.method.Patch(ctx, spath+"/items/"+strconv.Itoa(itemID), params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeCustomField(resp)
+}
+
+// DeleteItem deletes the item from the list type custom field.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-list-item-for-list-type-custom-field
+func (s *CustomFieldService) DeleteItem(target ProjectIDOrKeyGetter, customFieldID, itemID int) (*CustomField, error) {
+	return s.DeleteItemContext(context.Background(), target, customFieldID, itemID)
+}
+
+// DeleteItemContext is like DeleteItem but with the context.
+func (s *CustomFieldService) DeleteItemContext(ctx context.Context, target ProjectIDOrKeyGetter, customFieldID, itemID int) (*CustomField, error) {
+	spath, err := customFieldPath(target, customFieldID)
+	if err != nil {
+		return nil, err
+	}
+	if itemID < 1 {
+		return nil, fmt.Errorf("itemID must be 1 or more: %d", itemID)
+	}
+
+	resp, err := s.method.Delete(ctx, spath+"/items/"+strconv.Itoa(itemID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeCustomField(resp)
+}
+
+// CustomFieldValue represents a value of the custom field set to the issue.
+// The value is decoded by the type of the custom field.
+// Use AsText, AsNumber, AsDate or AsItems to get the value.
+type CustomFieldValue struct {
+	ID          int
+	FieldTypeID int
+	Name        string
+	OtherValue  string
+
+	value interface{}
+}
+
+type customFieldValueJSON struct {
+	ID          int             `json:"id,omitempty"`
+	FieldTypeID int             `json:"fieldTypeId,omitempty"`
+	TypeID      int             `json:"typeId,omitempty"`
+	Name        string          `json:"name,omitempty"`
+	Value       json.RawMessage `json:"value,omitempty"`
+	OtherValue  *string         `json:"otherValue,omitempty"`
+}
+
+// UnmarshalJSON decodes the value by `fieldTypeId` or `typeId`.
+func (v *CustomFieldValue) UnmarshalJSON(b []byte) error {
+	j := customFieldValueJSON{}
+	if err := json.Unmarshal(b, &j); err != nil {
+		return err
+	}
+
+	v.ID = j.ID
+	v.FieldTypeID = j.FieldTypeID
+	if v.FieldTypeID == 0 {
+		v.FieldTypeID = j.TypeID
+	}
+	v.Name = j.Name
+	v.OtherValue = ""
+	if j.OtherValue != nil {
+		v.OtherValue = *j.OtherValue
+	}
+	v.value = nil
+
+	if len(j.Value) == 0 || bytes.Equal(j.Value, []byte("null")) {
+		return nil
+	}
+
+	switch v.FieldTypeID {
+	case CustomFieldTypeText, CustomFieldTypeSentence:
+		s := ""
+		if err := json.Unmarshal(j.Value, &s); err != nil {
+			return err
+		}
+		v.value = s
+	case CustomFieldTypeNumber:
+		n, err := decodeCustomFieldNumber(j.Value)
+		if err != nil {
+			return err
+		}
+		v.value = n
+	case CustomFieldTypeDate:
+		d := Date{}
+		if err := json.Unmarshal(j.Value, &d); err != nil {
+			return err
+		}
+		if d.IsZero() {
+			return nil
+		}
+		v.value = d
+	case CustomFieldTypeSingleList, CustomFieldTypeRadio:
+		item := CustomFieldItem{}
+		if err := json.Unmarshal(j.Value, &item); err != nil {
+			return err
+		}
+		v.value = []*CustomFieldItem{&item}
+	case CustomFieldTypeMultipleList, CustomFieldTypeCheckBox:
+		items := []*CustomFieldItem{}
+		if err := json.Unmarshal(j.Value, &items); err != nil {
+			return err
+		}
+		v.value = items
+	default:
+		v.value = append(json.RawMessage(nil), j.Value...)
+	}
+
+	return nil
+}
+
+func decodeCustomFieldNumber(b json.RawMessage) (float64, error) {
+	n := 0.0
+	if err := json.Unmarshal(b, &n); err == nil {
+		return n, nil
+	}
+
+	s := ""
+	if err := json.Unmarshal(b, &s); err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// MarshalJSON encodes the value in the same form as Backlog API.
+func (v *CustomFieldValue) MarshalJSON() ([]byte, error) {
+	j := customFieldValueJSON{
+		ID:          v.ID,
+		FieldTypeID: v.FieldTypeID,
+		Name:        v.Name,
+	}
+	if v.OtherValue != "" {
+		j.OtherValue = &v.OtherValue
+	}
+
+	var value interface{}
+	switch x := v.value.(type) {
+	case Date:
+		value = x
+	case []*CustomFieldItem:
+		value = x
+		if (v.FieldTypeID == CustomFieldTypeSingleList || v.FieldTypeID == CustomFieldTypeRadio) && len(x) == 1 {
+			value = x[0]
+		}
+	default:
+		value = x
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	j.Value = b
+
+	return json.Marshal(j)
+}
+
+// IsEmpty reports whether no value is set to the custom field.
+func (v *CustomFieldValue) IsEmpty() bool {
+	return v.value == nil
+}
+
+func (v *CustomFieldValue) typeError(want string) error {
+	return fmt.Errorf("custom field %d is not %s: fieldTypeId %d", v.ID, want, v.FieldTypeID)
+}
+
+// AsText returns the value of text or sentence custom field.
+func (v *CustomFieldValue) AsText() (string, error) {
+	if v.FieldTypeID != CustomFieldTypeText && v.FieldTypeID != CustomFieldTypeSentence {
+		return "", v.typeError("text")
+	}
+	s, _ := v.value.(string)
+	return s, nil
+}
+
+// AsNumber returns the value of numeric custom field.
+func (v *CustomFieldValue) AsNumber() (float64, error) {
+	if v.FieldTypeID != CustomFieldTypeNumber {
+		return 0, v.typeError("number")
+	}
+	n, _ := v.value.(float64)
+	return n, nil
+}
+
+// AsDate returns the value of date custom field.
+func (v *CustomFieldValue) AsDate() (Date, error) {
+	if v.FieldTypeID != CustomFieldTypeDate {
+		return Date{}, v.typeError("date")
+	}
+	d, _ := v.value.(Date)
+	return d, nil
+}
+
+// AsItems returns the selected items of list, checkbox or radio custom field.
+// Single list and radio custom fields have one item at most.
+func (v *CustomFieldValue) AsItems() ([]*CustomFieldItem, error) {
+	switch v.FieldTypeID {
+	case CustomFieldTypeSingleList, CustomFieldTypeMultipleList, CustomFieldTypeCheckBox, CustomFieldTypeRadio:
+	default:
+		return nil, v.typeError("list")
+	}
+	items, _ := v.value.([]*CustomFieldItem)
+	return items, nil
 }
@@ -0,0 +1,488 @@
+package backlog_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func readCustomFieldValues(t *testing.T) []*backlog.CustomFieldValue {
+	b, err := ioutil.ReadFile("testdata/json/custom_field_value_list.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := []*backlog.CustomFieldValue{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestCustomFieldValue_UnmarshalJSON(t *testing.T) {
+	v := readCustomFieldValues(t)
+	assert.Len(t, v, 9)
+
+	text, err := v[0].AsText()
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", text)
+
+	sentence, err := v[1].AsText()
+	assert.NoError(t, err)
+	assert.Equal(t, "line1\nline2", sentence)
+
+	number, err := v[2].AsNumber()
+	assert.NoError(t, err)
+	assert.Equal(t, 12.5, number)
+
+	date, err := v[3].AsDate()
+	assert.NoError(t, err)
+	assert.Equal(t, backlog.NewDate(2020, time.April, 1), date)
+
+	single, err := v[4].AsItems()
+	assert.NoError(t, err)
+	assert.Len(t, single, 1)
+	assert.Equal(t, "Windows 8", single[0].Name)
+	assert.Equal(t, "", v[4].OtherValue)
+
+	multiple, err := v[5].AsItems()
+	assert.NoError(t, err)
+	assert.Len(t, multiple, 2)
+	assert.Equal(t, 2, multiple[1].ID)
+	assert.Equal(t, "Linux", v[5].OtherValue)
+
+	checkbox, err := v[6].AsItems()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, checkbox[0].ID)
+
+	radio, err := v[7].AsItems()
+	assert.NoError(t, err)
+	assert.Equal(t, "yes", radio[0].Name)
+
+	assert.True(t, v[8].IsEmpty())
+	empty, err := v[8].AsNumber()
+	assert.NoError(t, err)
+	assert.Zero(t, empty)
+	assert.False(t, v[2].IsEmpty())
+}
+
+func TestCustomFieldValue_UnmarshalJSON_variants(t *testing.T) {
+	cases := map[string]struct {
+		json      string
+		check     func(t *testing.T, v *backlog.CustomFieldValue)
+		wantError bool
+	}{
+		"typeId": {
+			json: `{"id":1,"typeId":1,"value":"text"}`,
+			check: func(t *testing.T, v *backlog.CustomFieldValue) {
+				assert.Equal(t, backlog.CustomFieldTypeText, v.FieldTypeID)
+			},
+		},
+		"numberAsString": {
+			json: `{"id":1,"fieldTypeId":3,"value":"3.25"}`,
+			check: func(t *testing.T, v *backlog.CustomFieldValue) {
+				n, _ := v.AsNumber()
+				assert.Equal(t, 3.25, n)
+			},
+		},
+		"dateTime": {
+			json: `{"id":1,"fieldTypeId":4,"value":"2020-04-01T09:00:00Z"}`,
+			check: func(t *testing.T, v *backlog.CustomFieldValue) {
+				d, _ := v.AsDate()
+				assert.Equal(t, backlog.NewDate(2020, time.April, 1), d)
+			},
+		},
+		"unknownType": {
+			json: `{"id":1,"fieldTypeId":99,"value":{"a":1}}`,
+			check: func(t *testing.T, v *backlog.CustomFieldValue) {
+				assert.False(t, v.IsEmpty())
+			},
+		},
+		"invalidNumber": {
+			json:      `{"id":1,"fieldTypeId":3,"value":"abc"}`,
+			wantError: true,
+		},
+		"invalidDate": {
+			json:      `{"id":1,"fieldTypeId":4,"value":"2020/04/01"}`,
+			wantError: true,
+		},
+		"invalidItems": {
+			json:      `{"id":1,"fieldTypeId":6,"value":{"id":1}}`,
+			wantError: true,
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			v := &backlog.CustomFieldValue{}
+			if err := json.Unmarshal([]byte(tc.json), v); tc.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				tc.check(t, v)
+			}
+		})
+	}
+}
+
+func TestCustomFieldValue_MarshalJSON(t *testing.T) {
+	v := readCustomFieldValues(t)
+
+	b, err := json.Marshal(v)
+	assert.NoError(t, err)
+
+	got := []*backlog.CustomFieldValue{}
+	assert.NoError(t, json.Unmarshal(b, &got))
+	assert.Equal(t, v, got)
+
+	single := map[string]interface{}{}
+	b, err = json.Marshal(v[4])
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(b, &single))
+	assert.Equal(t, float64(1), single["value"].(map[string]interface{})["id"])
+
+	date := map[string]interface{}{}
+	b, err = json.Marshal(v[3])
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(b, &date))
+	assert.Equal(t, "2020-04-01", date["value"])
+}
+
+func TestCustomFieldValue_As_typeError(t *testing.T) {
+	v := readCustomFieldValues(t)
+
+	_, err := v[0].AsNumber()
+	assert.Error(t, err)
+	_, err = v[0].AsDate()
+	assert.Error(t, err)
+	_, err = v[0].AsItems()
+	assert.Error(t, err)
+	_, err = v[2].AsText()
+	assert.Error(t, err)
+}
+
+func TestCustomFieldService_All(t *testing.T) {
+	bj, err := os.Open("testdata/json/custom_field_list.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.CustomFieldService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/customFields", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	fields, err := s.All(backlog.ProjectKey("TEST"))
+	assert.NoError(t, err)
+	assert.Len(t, fields, 2)
+	assert.Equal(t, backlog.CustomFieldTypeMultipleList, fields[0].TypeID)
+	assert.Equal(t, "Windows 8", fields[0].Items[0].Name)
+	assert.Equal(t, []int{1, 2}, fields[1].ApplicableIssueTypeIDs)
+	assert.Equal(t, "yen", fields[1].Unit)
+}
+
+func TestCustomFieldService_Create(t *testing.T) {
+	bj, err := os.Open("testdata/json/custom_field.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.CustomFieldService{
+		Option: &backlog.CustomFieldOptionService{},
+	}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/customFields", spath)
+			v := *params.ExportURLValues()
+			assert.Equal(t, []string{"6"}, v["typeId"])
+			assert.Equal(t, []string{"custom"}, v["name"])
+			assert.Equal(t, []string{"Windows 8", "Windows 10"}, v["items[]"])
+			assert.Equal(t, []string{"1", "2"}, v["applicableIssueTypes[]"])
+			assert.Equal(t, []string{"true"}, v["allowAddItem"])
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	o := s.Option
+	field, err := s.Create(backlog.ProjectKey("TEST"), backlog.CustomFieldTypeMultipleList, "custom",
+		o.WithItems([]string{"Windows 8", "Windows 10"}),
+		o.WithApplicableIssueTypes([]int{1, 2}),
+		o.WithAllowAddItem(true),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, field.ID)
+	assert.Len(t, field.Items, 2)
+}
+
+func TestCustomFieldService_Create_param_error(t *testing.T) {
+	s := &backlog.CustomFieldService{
+		Option: &backlog.CustomFieldOptionService{},
+	}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Post must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	field, err := s.Create(backlog.ProjectKey("TEST"), 0, "custom")
+	assert.Nil(t, field)
+	assert.Error(t, err)
+
+	field, err = s.Create(backlog.ProjectKey("TEST"), 9, "custom")
+	assert.Nil(t, field)
+	assert.Error(t, err)
+
+	field, err = s.Create(backlog.ProjectKey("TEST"), backlog.CustomFieldTypeText, "")
+	assert.Nil(t, field)
+	assert.Error(t, err)
+
+	field, err = s.Create(backlog.ProjectKey("TEST"), backlog.CustomFieldTypeDate, "date", s.Option.WithInitialValueType(4))
+	assert.Nil(t, field)
+	assert.Error(t, err)
+
+	field, err = s.Create(backlog.ProjectKey("TEST"), backlog.CustomFieldTypeSingleList, "list", s.Option.WithItems([]string{"a", ""}))
+	assert.Nil(t, field)
+	assert.Error(t, err)
+}
+
+func TestCustomFieldService_Update(t *testing.T) {
+	bj, err := os.Open("testdata/json/custom_field.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.CustomFieldService{
+		Option: &backlog.CustomFieldOptionService{},
+	}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/customFields/2", spath)
+			assert.Equal(t, "0", params.Get("min"))
+			assert.Equal(t, "100.5", params.Get("max"))
+			assert.Equal(t, "yen", params.Get("unit"))
+			assert.Equal(t, "true", params.Get("required"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	o := s.Option
+	field, err := s.Update(backlog.ProjectKey("TEST"), 2, o.WithMin(0), o.WithMax(100.5), o.WithUnit("yen"), o.WithRequired(true))
+	assert.NoError(t, err)
+	assert.NotNil(t, field)
+}
+
+func TestCustomFieldService_Update_date(t *testing.T) {
+	bj, err := os.Open("testdata/json/custom_field.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.CustomFieldService{
+		Option: &backlog.CustomFieldOptionService{},
+	}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "2020-01-01", params.Get("min"))
+			assert.Equal(t, "2020-12-31", params.Get("max"))
+			assert.Equal(t, "2", params.Get("initialValueType"))
+			assert.Equal(t, "7", params.Get("initialShift"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	o := s.Option
+	_, err = s.Update(backlog.ProjectKey("TEST"), 3,
+		o.WithMinDate(backlog.NewDate(2020, time.January, 1)),
+		o.WithMaxDate(backlog.NewDate(2020, time.December, 31)),
+		o.WithInitialValueType(2),
+		o.WithInitialShift(7),
+	)
+	assert.NoError(t, err)
+}
+
+func TestCustomFieldService_Update_param_error(t *testing.T) {
+	s := &backlog.CustomFieldService{
+		Option: &backlog.CustomFieldOptionService{},
+	}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Patch must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	field, err := s.Update(backlog.ProjectKey("TEST"), 0, s.Option.WithName("custom"))
+	assert.Nil(t, field)
+	assert.Error(t, err)
+
+	field, err = s.Update(backlog.ProjectKey("TEST"), 1)
+	assert.Nil(t, field)
+	assert.Error(t, err)
+}
+
+func TestCustomFieldService_Delete(t *testing.T) {
+	bj, err := os.Open("testdata/json/custom_field.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.CustomFieldService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/customFields/1", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	field, err := s.Delete(backlog.ProjectKey("TEST"), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, field.ID)
+}
+
+func TestCustomFieldService_AddItem(t *testing.T) {
+	bj, err := os.Open("testdata/json/custom_field.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.CustomFieldService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/customFields/1/items", spath)
+			assert.Equal(t, "Windows 10", params.Get("name"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	field, err := s.AddItem(backlog.ProjectKey("TEST"), 1, "Windows 10")
+	assert.NoError(t, err)
+	assert.Equal(t, "Windows 10", field.Items[1].Name)
+}
+
+func TestCustomFieldService_UpdateItem(t *testing.T) {
+	bj, err := os.Open("testdata/json/custom_field.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.CustomFieldService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/customFields/1/items/2", spath)
+			assert.Equal(t, "Windows 10", params.Get("name"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	field, err := s.UpdateItem(backlog.ProjectKey("TEST"), 1, 2, "Windows 10")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, field.ID)
+}
+
+func TestCustomFieldService_DeleteItem(t *testing.T) {
+	bj, err := os.Open("testdata/json/custom_field.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.CustomFieldService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/customFields/1/items/2", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	field, err := s.DeleteItem(backlog.ProjectKey("TEST"), 1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, field.ID)
+}
+
+func TestCustomFieldService_Item_param_error(t *testing.T) {
+	s := &backlog.CustomFieldService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Post must never be called")
+			return nil, errors.New("error")
+		},
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Patch must never be called")
+			return nil, errors.New("error")
+		},
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Delete must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	field, err := s.AddItem(backlog.ProjectKey("TEST"), 0, "Windows 10")
+	assert.Nil(t, field)
+	assert.Error(t, err)
+
+	field, err = s.AddItem(backlog.ProjectKey("TEST"), 1, "")
+	assert.Nil(t, field)
+	assert.Error(t, err)
+
+	field, err = s.UpdateItem(backlog.ProjectKey("TEST"), 1, 0, "Windows 10")
+	assert.Nil(t, field)
+	assert.Error(t, err)
+
+	field, err = s.DeleteItem(backlog.ProjectKey("TEST"), 1, 0)
+	assert.Nil(t, field)
+	assert.Error(t, err)
+
+	field, err = s.DeleteItem(backlog.ProjectKey(""), 1, 2)
+	assert.Nil(t, field)
+	assert.Error(t, err)
+}
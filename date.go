@@ -0,0 +1,115 @@
+package backlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// Date represents a date without time of day, such as the due date of issues.
+// The zero value means that no date is set, and it is encoded as JSON null.
+type Date struct {
+	t time.Time
+}
+
+// NewDate returns the date of the year, month and day.
+func NewDate(year int, month time.Month, day int) Date {
+	return Date{t: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+// DateOf returns the date of t in the location of t.
+// It returns the zero Date if t is zero.
+func DateOf(t time.Time) Date {
+	if t.IsZero() {
+		return Date{}
+	}
+	return NewDate(t.Date())
+}
+
+// ParseDate parses s as yyyy-MM-dd or RFC3339.
+// The time of day of RFC3339 is ignored.
+// It returns the zero Date if s is empty.
+func ParseDate(s string) (Date, error) {
+	if s == "" {
+		return Date{}, nil
+	}
+
+	t, err := time.Parse(dateFormat, s)
+	if err != nil {
+		if t, err = time.Parse(time.RFC3339, s); err != nil {
+			return Date{}, err
+		}
+	}
+
+	return DateOf(t), nil
+}
+
+// IsZero reports whether no date is set.
+func (d Date) IsZero() bool {
+	return d.t.IsZero()
+}
+
+// Time returns the date as midnight in UTC.
+func (d Date) Time() time.Time {
+	return d.t
+}
+
+// In returns the date as midnight in loc.
+func (d Date) In(loc *time.Location) time.Time {
+	if d.IsZero() {
+		return time.Time{}
+	}
+	return time.Date(d.t.Year(), d.t.Month(), d.t.Day(), 0, 0, 0, 0, loc)
+}
+
+// Equal reports whether d and u are the same date.
+func (d Date) Equal(u Date) bool {
+	return d.t.Equal(u.t)
+}
+
+// Before reports whether d is before u.
+func (d Date) Before(u Date) bool {
+	return d.t.Before(u.t)
+}
+
+// After reports whether d is after u.
+func (d Date) After(u Date) bool {
+	return d.t.After(u.t)
+}
+
+// String returns the date formatted as yyyy-MM-dd, or empty string if it is zero.
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.t.Format(dateFormat)
+}
+
+// MarshalJSON encodes the date as yyyy-MM-dd, or null if it is zero.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON decodes null, empty string, yyyy-MM-dd and RFC3339.
+func (d *Date) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*d = Date{}
+		return nil
+	}
+
+	s := ""
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	v, err := ParseDate(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+
+	return nil
+}
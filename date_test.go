@@ -0,0 +1,114 @@
+package backlog_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDate_UnmarshalJSON(t *testing.T) {
+	cases := map[string]struct {
+		json    string
+		want    backlog.Date
+		wantErr bool
+	}{
+		"null": {
+			json: `null`,
+			want: backlog.Date{},
+		},
+		"empty": {
+			json: `""`,
+			want: backlog.Date{},
+		},
+		"date_only": {
+			json: `"2019-10-01"`,
+			want: backlog.NewDate(2019, time.October, 1),
+		},
+		"rfc3339": {
+			json: `"2019-10-01T00:00:00Z"`,
+			want: backlog.NewDate(2019, time.October, 1),
+		},
+		"rfc3339_offset": {
+			json: `"2019-10-01T23:30:00+09:00"`,
+			want: backlog.NewDate(2019, time.October, 1),
+		},
+		"invalied_format": {
+			json:    `"2019/10/01"`,
+			wantErr: true,
+		},
+		"number": {
+			json:    `20191001`,
+			wantErr: true,
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			d := backlog.NewDate(2000, time.January, 1)
+			err := json.Unmarshal([]byte(tc.json), &d)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, d)
+		})
+	}
+}
+
+func TestDate_MarshalJSON(t *testing.T) {
+	v := struct {
+		StartDate backlog.Date `json:"startDate"`
+		DueDate   backlog.Date `json:"dueDate,omitempty"`
+	}{
+		StartDate: backlog.NewDate(2019, time.October, 1),
+	}
+
+	b, err := json.Marshal(v)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"startDate":"2019-10-01","dueDate":null}`, string(b))
+}
+
+func TestDateOf(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+
+	assert.Equal(t, backlog.NewDate(2019, time.October, 1), backlog.DateOf(time.Date(2019, 10, 1, 23, 30, 0, 0, jst)))
+	assert.True(t, backlog.DateOf(time.Time{}).IsZero())
+}
+
+func TestParseDate(t *testing.T) {
+	d, err := backlog.ParseDate("2019-10-01")
+	assert.NoError(t, err)
+	assert.Equal(t, "2019-10-01", d.String())
+
+	d, err = backlog.ParseDate("")
+	assert.NoError(t, err)
+	assert.True(t, d.IsZero())
+
+	_, err = backlog.ParseDate("10/01/2019")
+	assert.Error(t, err)
+}
+
+func TestDate_conversion(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	d := backlog.NewDate(2019, time.October, 1)
+
+	assert.Equal(t, time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC), d.Time())
+	assert.True(t, time.Date(2019, 10, 1, 0, 0, 0, 0, jst).Equal(d.In(jst)))
+	assert.True(t, backlog.Date{}.In(jst).IsZero())
+	assert.Equal(t, "", backlog.Date{}.String())
+}
+
+func TestDate_compare(t *testing.T) {
+	d := backlog.NewDate(2019, time.October, 1)
+	next := backlog.NewDate(2019, time.October, 2)
+
+	assert.True(t, d.Equal(backlog.DateOf(time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC))))
+	assert.True(t, d.Before(next))
+	assert.True(t, next.After(d))
+	assert.False(t, d.After(next))
+}
@@ -0,0 +1,239 @@
+package backlog
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines around changes in unified diff.
+const diffContext = 3
+
+// diffEdit is one line of the difference.
+// The op is ' ' for unchanged line, '-' for deleted line and '+' for inserted line.
+type diffEdit struct {
+	op   byte
+	text string
+}
+
+// splitLines splits the text into lines without line breaks.
+func splitLines(s string) []string {
+	s = strings.Replace(s, "\r\n", "\n", -1)
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffLines returns the shortest edits from a to b by Myers' algorithm.
+// It splits the problem at the middle of the path, so it needs only linear space.
+func diffLines(a, b []string) []diffEdit {
+	edits := appendDiff(make([]diffEdit, 0, len(a)+len(b)), a, b)
+
+	// Deleted lines are put before inserted lines in each run of changes.
+	for i := 0; i < len(edits); {
+		if edits[i].op == ' ' {
+			i++
+			continue
+		}
+		j := i
+		for j < len(edits) && edits[j].op != ' ' {
+			j++
+		}
+		sort.SliceStable(edits[i:j], func(x, y int) bool {
+			return edits[i+x].op == '-' && edits[i+y].op == '+'
+		})
+		i = j
+	}
+
+	return edits
+}
+
+func appendDiff(edits []diffEdit, a, b []string) []diffEdit {
+	// The common prefix and suffix are unchanged.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		edits = append(edits, diffEdit{op: ' ', text: a[prefix]})
+		prefix++
+	}
+	a, b = a[prefix:], b[prefix:]
+	suffix := 0
+	for suffix < len(a) && suffix < len(b) && a[len(a)-suffix-1] == b[len(b)-suffix-1] {
+		suffix++
+	}
+	common := a[len(a)-suffix:]
+	a, b = a[:len(a)-suffix], b[:len(b)-suffix]
+
+	switch {
+	case len(a) == 0:
+		for _, text := range b {
+			edits = append(edits, diffEdit{op: '+', text: text})
+		}
+	case len(b) == 0:
+		for _, text := range a {
+			edits = append(edits, diffEdit{op: '-', text: text})
+		}
+	default:
+		x, y := bisectDiff(a, b)
+		edits = appendDiff(edits, a[:x], b[:y])
+		edits = appendDiff(edits, a[x:], b[y:])
+	}
+
+	for _, text := range common {
+		edits = append(edits, diffEdit{op: ' ', text: text})
+	}
+	return edits
+}
+
+// bisectDiff returns the point where the paths from the both ends of the edit graph meet.
+// The a and b must not be empty and must differ at the first and the last lines,
+// so the both parts divided at the point are smaller than the whole.
+func bisectDiff(a, b []string) (int, int) {
+	n, m := len(a), len(b)
+	maxD := (n + m + 1) / 2
+	off := maxD
+	// vf[off+k] and vb[off+k] are the furthest x on the diagonal k from the start and the end.
+	vf := make([]int, 2*maxD+2)
+	vb := make([]int, 2*maxD+2)
+	for i := range vf {
+		vf[i], vb[i] = -1, -1
+	}
+	vf[off+1], vb[off+1] = 0, 0
+
+	delta := n - m
+	// The forward path meets the backward path of the same step if delta is odd.
+	front := delta%2 != 0
+	// The ranges of diagonals which are still inside the edit graph.
+	fStart, fEnd, bStart, bEnd := 0, 0, 0, 0
+
+	for d := 0; d < maxD; d++ {
+		for k := -d + fStart; k <= d-fEnd; k += 2 {
+			x := 0
+			if k == -d || (k != d && vf[off+k-1] < vf[off+k+1]) {
+				x = vf[off+k+1]
+			} else {
+				x = vf[off+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			vf[off+k] = x
+			switch {
+			case x > n:
+				fEnd += 2
+			case y > m:
+				fStart += 2
+			case front:
+				if kb := off + delta - k; kb >= 0 && kb < len(vb) && vb[kb] != -1 && x >= n-vb[kb] {
+					return x, y
+				}
+			}
+		}
+
+		for k := -d + bStart; k <= d-bEnd; k += 2 {
+			x := 0
+			if k == -d || (k != d && vb[off+k-1] < vb[off+k+1]) {
+				x = vb[off+k+1]
+			} else {
+				x = vb[off+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[n-x-1] == b[m-y-1] {
+				x++
+				y++
+			}
+			vb[off+k] = x
+			switch {
+			case x > n:
+				bEnd += 2
+			case y > m:
+				bStart += 2
+			case !front:
+				if kf := off + delta - k; kf >= 0 && kf < len(vf) && vf[kf] != -1 {
+					fx := vf[kf]
+					if fx >= n-x {
+						return fx, off + fx - kf
+					}
+				}
+			}
+		}
+	}
+
+	// The paths always meet, but split at the end in case.
+	return n, 0
+}
+
+// unifiedDiff returns the difference between the texts in unified format.
+// It returns empty string if the texts have the same lines.
+func unifiedDiff(fromLabel, toLabel, from, to string) string {
+	edits := diffLines(splitLines(from), splitLines(to))
+
+	changes := []int{}
+	for i, e := range edits {
+		if e.op != ' ' {
+			changes = append(changes, i)
+		}
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+
+	// aLine[i] and bLine[i] are the numbers of lines before edits[i].
+	aLine := make([]int, len(edits)+1)
+	bLine := make([]int, len(edits)+1)
+	for i, e := range edits {
+		aLine[i+1], bLine[i+1] = aLine[i], bLine[i]
+		if e.op != '+' {
+			aLine[i+1]++
+		}
+		if e.op != '-' {
+			bLine[i+1]++
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("--- " + fromLabel + "\n")
+	sb.WriteString("+++ " + toLabel + "\n")
+
+	for i := 0; i < len(changes); {
+		// Changes separated by few unchanged lines are in the same hunk.
+		j := i
+		for j+1 < len(changes) && changes[j+1]-changes[j]-1 <= 2*diffContext {
+			j++
+		}
+		start := changes[i] - diffContext
+		if start < 0 {
+			start = 0
+		}
+		end := changes[j] + diffContext + 1
+		if end > len(edits) {
+			end = len(edits)
+		}
+
+		sb.WriteString("@@ -" + hunkRange(aLine[start], aLine[end]-aLine[start]))
+		sb.WriteString(" +" + hunkRange(bLine[start], bLine[end]-bLine[start]) + " @@\n")
+		for _, e := range edits[start:end] {
+			sb.WriteByte(e.op)
+			sb.WriteString(e.text + "\n")
+		}
+
+		i = j + 1
+	}
+
+	return sb.String()
+}
+
+// hunkRange formats the range of lines in the hunk header.
+// The start is the number of lines before the hunk.
+func hunkRange(start, count int) string {
+	switch count {
+	case 0:
+		return strconv.Itoa(start) + ",0"
+	case 1:
+		return strconv.Itoa(start + 1)
+	default:
+		return strconv.Itoa(start+1) + "," + strconv.Itoa(count)
+	}
+}
@@ -0,0 +1,160 @@
+package backlog_test
+
+import (
+	"math/rand"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	cases := map[string]struct {
+		from string
+		to   string
+		want string
+	}{
+		"same": {
+			from: "a\nb\n",
+			to:   "a\r\nb",
+			want: "",
+		},
+		"empty": {
+			from: "",
+			to:   "",
+			want: "",
+		},
+		"insert_to_empty": {
+			from: "",
+			to:   "a\nb\n",
+			want: "--- from\n+++ to\n@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		"delete_all": {
+			from: "a\n",
+			to:   "",
+			want: "--- from\n+++ to\n@@ -1 +0,0 @@\n-a\n",
+		},
+		"change": {
+			from: "# Home\nWelcome.\n",
+			to:   "# Home\nWelcome to the project.\nSee the manual.\n",
+			want: "--- from\n+++ to\n@@ -1,2 +1,3 @@\n # Home\n-Welcome.\n+Welcome to the project.\n+See the manual.\n",
+		},
+		"hunks": {
+			from: "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			to:   "0\n1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\nx\n",
+			want: "--- from\n+++ to\n@@ -1,3 +1,4 @@\n+0\n 1\n 2\n 3\n@@ -9,4 +10,4 @@\n 9\n 10\n 11\n-12\n+x\n",
+		},
+		"merged_hunks": {
+			from: "1\n2\n3\n4\n5\n6\n7\n8\n",
+			to:   "x\n2\n3\n4\n5\n6\n7\ny\n",
+			want: "--- from\n+++ to\n@@ -1,8 +1,8 @@\n-1\n+x\n 2\n 3\n 4\n 5\n 6\n 7\n-8\n+y\n",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, tc.want, backlog.ExportUnifiedDiff("from", "to", tc.from, tc.to))
+		})
+	}
+}
+
+func TestUnifiedDiff_reverse(t *testing.T) {
+	from := strings.Repeat("a\nb\nc\n", 50)
+	to := strings.Repeat("b\nc\nd\n", 50)
+
+	// Applying the lines of the diff gives back the both texts.
+	var a, b []string
+	for _, line := range strings.Split(backlog.ExportUnifiedDiff("from", "to", from, to), "\n") {
+		if line == "" || strings.HasPrefix(line, "---") || strings.HasPrefix(line, "+++") || strings.HasPrefix(line, "@@") {
+			continue
+		}
+		switch line[0] {
+		case ' ':
+			a = append(a, line[1:])
+			b = append(b, line[1:])
+		case '-':
+			a = append(a, line[1:])
+		case '+':
+			b = append(b, line[1:])
+		}
+	}
+	assert.Equal(t, strings.TrimSuffix(from, "\n"), strings.Join(a, "\n"))
+	assert.Equal(t, strings.TrimSuffix(to, "\n"), strings.Join(b, "\n"))
+}
+
+func TestUnifiedDiff_large(t *testing.T) {
+	const lines = 5000
+	var from, to strings.Builder
+	for i := 0; i < lines; i++ {
+		from.WriteString("from " + strconv.Itoa(i) + "\n")
+		to.WriteString("to " + strconv.Itoa(i) + "\n")
+	}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	diff := backlog.ExportUnifiedDiff("from", "to", from.String(), to.String())
+	runtime.ReadMemStats(&after)
+
+	// The memory is linear in the number of lines even if all lines differ.
+	assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(64<<20))
+
+	deleted, inserted := 0, 0
+	for _, line := range strings.Split(diff, "\n")[3:] {
+		switch {
+		case strings.HasPrefix(line, "-"):
+			deleted++
+		case strings.HasPrefix(line, "+"):
+			inserted++
+		}
+	}
+	assert.Equal(t, lines, deleted)
+	assert.Equal(t, lines, inserted)
+	assert.True(t, strings.HasPrefix(diff, "--- from\n+++ to\n@@ -1,5000 +1,5000 @@\n-from 0\n"))
+}
+
+func TestUnifiedDiff_minimal(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randomLines := func() []string {
+		lines := make([]string, r.Intn(12))
+		for i := range lines {
+			lines[i] = string(rune('a' + r.Intn(3)))
+		}
+		return lines
+	}
+
+	for i := 0; i < 500; i++ {
+		a, b := randomLines(), randomLines()
+
+		// The length of the longest common subsequence by dynamic programming.
+		lcs := make([][]int, len(a)+1)
+		for x := range lcs {
+			lcs[x] = make([]int, len(b)+1)
+		}
+		for x := len(a) - 1; x >= 0; x-- {
+			for y := len(b) - 1; y >= 0; y-- {
+				switch {
+				case a[x] == b[y]:
+					lcs[x][y] = lcs[x+1][y+1] + 1
+				case lcs[x+1][y] > lcs[x][y+1]:
+					lcs[x][y] = lcs[x+1][y]
+				default:
+					lcs[x][y] = lcs[x][y+1]
+				}
+			}
+		}
+
+		diff := backlog.ExportUnifiedDiff("from", "to", strings.Join(a, "\n"), strings.Join(b, "\n"))
+		changes := 0
+		for _, line := range strings.Split(diff, "\n") {
+			if (strings.HasPrefix(line, "-") || strings.HasPrefix(line, "+")) &&
+				!strings.HasPrefix(line, "---") && !strings.HasPrefix(line, "+++") {
+				changes++
+			}
+		}
+		assert.Equal(t, len(a)+len(b)-2*lcs[0][0], changes, "%q %q", a, b)
+	}
+}
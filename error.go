@@ -1,19 +1,71 @@
 package backlog
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
 	"strings"
 )
 
+// ErrorCode represents error code of Backlog API.
+// It implements error interface to be compared by errors.Is.
+//
+//	if errors.Is(err, backlog.NoResourceError) {
+//		// ...
+//	}
+type ErrorCode int
+
+func (c ErrorCode) String() string {
+	switch c {
+	case InternalError:
+		return "InternalError"
+	case LicenceError:
+		return "LicenceError"
+	case LicenceExpiredError:
+		return "LicenceExpiredError"
+	case AccessDeniedError:
+		return "AccessDeniedError"
+	case UnauthorizedOperationError:
+		return "UnauthorizedOperationError"
+	case NoResourceError:
+		return "NoResourceError"
+	case InvalidRequestError:
+		return "InvalidRequestError"
+	case SpaceOverCapacityError:
+		return "SpaceOverCapacityError"
+	case ResourceOverflowError:
+		return "ResourceOverflowError"
+	case TooLargeFileError:
+		return "TooLargeFileError"
+	case AuthenticationError:
+		return "AuthenticationError"
+	case RequiredMFAError:
+		return "RequiredMFAError"
+	case TooManyRequestsError:
+		return "TooManyRequestsError"
+	default:
+		return "UnknownError(" + strconv.Itoa(int(c)) + ")"
+	}
+}
+
+func (c ErrorCode) Error() string {
+	return c.String()
+}
+
 // APIResponseError represents Error Response of Backlog API.
 type APIResponseError struct {
 	Errors []*Error `json:"errors,omitempty"`
+	// StatusCode is HTTP status code of the response.
+	StatusCode int `json:"-"`
+	// Path is URL path of the request.
+	Path string `json:"-"`
 }
 
 // Error message converted from API error is returned.
 func (e *Error) Error() string {
 	msg := fmt.Sprint("Massage:", e.Message)
-	msg += fmt.Sprint(", Code:", e.Code)
+	msg += fmt.Sprint(", Code:", int(e.Code))
 
 	if len(e.MoreInfo) != 0 {
 		msg += fmt.Sprint(", MoreInfo:", e.MoreInfo)
@@ -31,5 +83,82 @@ func (e *APIResponseError) Error() string {
 		msgs[i] = e.Errors[i].Error()
 	}
 
-	return strings.Join(msgs[:], "\n")
+	msg := strings.Join(msgs[:], "\n")
+	if e.StatusCode == 0 {
+		return msg
+	}
+
+	prefix := strconv.Itoa(e.StatusCode)
+	if e.Path != "" {
+		prefix += " " + e.Path
+	}
+	if msg == "" {
+		return prefix
+	}
+	return prefix + ": " + msg
+}
+
+// Is reports whether the target is ErrorCode included in the errors.
+func (e *APIResponseError) Is(target error) bool {
+	code, ok := target.(ErrorCode)
+	if !ok {
+		return false
+	}
+	return e.HasCode(code)
+}
+
+// HasCode reports whether the errors include the code.
+func (e *APIResponseError) HasCode(code ErrorCode) bool {
+	for _, err := range e.Errors {
+		if err.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// AsAPIResponseError returns APIResponseError in the error chain.
+// It returns nil if the error is not from Backlog API.
+func AsAPIResponseError(err error) *APIResponseError {
+	var e *APIResponseError
+	if errors.As(err, &e) {
+		return e
+	}
+	return nil
+}
+
+func isAPIError(err error, statusCode int, codes ...ErrorCode) bool {
+	e := AsAPIResponseError(err)
+	if e == nil {
+		return false
+	}
+	if e.StatusCode == statusCode {
+		return true
+	}
+	for _, code := range codes {
+		if e.HasCode(code) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNotFound reports whether the error means that the resource does not exist.
+func IsNotFound(err error) bool {
+	return isAPIError(err, http.StatusNotFound, NoResourceError)
+}
+
+// IsRateLimited reports whether the error means that the request exceeded the rate limit.
+func IsRateLimited(err error) bool {
+	return isAPIError(err, http.StatusTooManyRequests, TooManyRequestsError)
+}
+
+// IsUnauthorized reports whether the error means that the credential is invalid.
+func IsUnauthorized(err error) bool {
+	return isAPIError(err, http.StatusUnauthorized, AuthenticationError)
+}
+
+// IsForbidden reports whether the error means that the operation is not permitted.
+func IsForbidden(err error) bool {
+	return isAPIError(err, http.StatusForbidden, AccessDeniedError, UnauthorizedOperationError)
 }
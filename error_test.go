@@ -0,0 +1,168 @@
+package backlog_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorCode_String(t *testing.T) {
+	cases := map[string]struct {
+		code backlog.ErrorCode
+		want string
+	}{
+		"InternalError": {
+			code: backlog.InternalError,
+			want: "InternalError",
+		},
+		"NoResourceError": {
+			code: backlog.NoResourceError,
+			want: "NoResourceError",
+		},
+		"TooManyRequestsError": {
+			code: backlog.TooManyRequestsError,
+			want: "TooManyRequestsError",
+		},
+		"unknown": {
+			code: backlog.ErrorCode(99),
+			want: "UnknownError(99)",
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.code.String())
+			assert.Equal(t, tc.want, tc.code.Error())
+		})
+	}
+}
+
+func TestErrorCode_values(t *testing.T) {
+	assert.Equal(t, backlog.ErrorCode(1), backlog.InternalError)
+	assert.Equal(t, backlog.ErrorCode(2), backlog.LicenceError)
+	assert.Equal(t, backlog.ErrorCode(3), backlog.LicenceExpiredError)
+	assert.Equal(t, backlog.ErrorCode(4), backlog.AccessDeniedError)
+	assert.Equal(t, backlog.ErrorCode(5), backlog.UnauthorizedOperationError)
+	assert.Equal(t, backlog.ErrorCode(6), backlog.NoResourceError)
+	assert.Equal(t, backlog.ErrorCode(7), backlog.InvalidRequestError)
+	assert.Equal(t, backlog.ErrorCode(8), backlog.SpaceOverCapacityError)
+	assert.Equal(t, backlog.ErrorCode(9), backlog.ResourceOverflowError)
+	assert.Equal(t, backlog.ErrorCode(10), backlog.TooLargeFileError)
+	assert.Equal(t, backlog.ErrorCode(11), backlog.AuthenticationError)
+	assert.Equal(t, backlog.ErrorCode(12), backlog.RequiredMFAError)
+	assert.Equal(t, backlog.ErrorCode(13), backlog.TooManyRequestsError)
+}
+
+func TestAPIResponseError_Error(t *testing.T) {
+	e := &backlog.APIResponseError{
+		Errors: []*backlog.Error{
+			{Message: "No project.", Code: backlog.NoResourceError},
+			{Message: "Invalid.", Code: backlog.InvalidRequestError, MoreInfo: "info"},
+		},
+	}
+	assert.Equal(t, "Massage:No project., Code:6\nMassage:Invalid., Code:7, MoreInfo:info", e.Error())
+
+	e.StatusCode = http.StatusNotFound
+	e.Path = "/api/v2/projects/TEST"
+	assert.Equal(t, "404 /api/v2/projects/TEST: Massage:No project., Code:6\nMassage:Invalid., Code:7, MoreInfo:info", e.Error())
+
+	e.Errors = nil
+	assert.Equal(t, "404 /api/v2/projects/TEST", e.Error())
+}
+
+func TestAPIResponseError_Is(t *testing.T) {
+	e := &backlog.APIResponseError{
+		Errors: []*backlog.Error{{Code: backlog.NoResourceError}},
+	}
+	wrapped := fmt.Errorf("wrapped: %w", e)
+
+	assert.True(t, errors.Is(wrapped, backlog.NoResourceError))
+	assert.False(t, errors.Is(wrapped, backlog.InternalError))
+	assert.False(t, errors.Is(wrapped, errors.New("error")))
+	assert.Equal(t, e, backlog.AsAPIResponseError(wrapped))
+	assert.Nil(t, backlog.AsAPIResponseError(errors.New("error")))
+}
+
+func TestIsErrorHelpers(t *testing.T) {
+	newErr := func(statusCode int, code backlog.ErrorCode) error {
+		return &backlog.APIResponseError{
+			Errors:     []*backlog.Error{{Code: code}},
+			StatusCode: statusCode,
+		}
+	}
+
+	cases := map[string]struct {
+		err          error
+		notFound     bool
+		rateLimited  bool
+		unauthorized bool
+		forbidden    bool
+	}{
+		"404": {
+			err:      newErr(http.StatusNotFound, backlog.NoResourceError),
+			notFound: true,
+		},
+		"no-resource-code": {
+			err:      newErr(http.StatusBadRequest, backlog.NoResourceError),
+			notFound: true,
+		},
+		"429": {
+			err:         newErr(http.StatusTooManyRequests, backlog.TooManyRequestsError),
+			rateLimited: true,
+		},
+		"401": {
+			err:          newErr(http.StatusUnauthorized, backlog.AuthenticationError),
+			unauthorized: true,
+		},
+		"403": {
+			err:       newErr(http.StatusForbidden, backlog.UnauthorizedOperationError),
+			forbidden: true,
+		},
+		"500": {
+			err: newErr(http.StatusInternalServerError, backlog.InternalError),
+		},
+		"not-api-error": {
+			err: errors.New("error"),
+		},
+		"nil": {
+			err: nil,
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, tc.notFound, backlog.IsNotFound(tc.err))
+			assert.Equal(t, tc.rateLimited, backlog.IsRateLimited(tc.err))
+			assert.Equal(t, tc.unauthorized, backlog.IsUnauthorized(tc.err))
+			assert.Equal(t, tc.forbidden, backlog.IsForbidden(tc.err))
+		})
+	}
+}
+
+func TestClient_Do_errorResponseDetail(t *testing.T) {
+	c := NewClientMock("https://test.backlog.com", "test", func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"errors":[{"message":"No project.","code":6,"moreInfo":""}]}`))),
+		}, nil
+	})
+
+	_, err := backlog.ExportClientGet(c, context.Background(), "projects/TEST", nil)
+
+	e := backlog.AsAPIResponseError(err)
+	if assert.NotNil(t, e) {
+		assert.Equal(t, http.StatusNotFound, e.StatusCode)
+		assert.Equal(t, "/api/v2/projects/TEST", e.Path)
+		assert.Equal(t, backlog.NoResourceError, e.Errors[0].Code)
+	}
+	assert.True(t, backlog.IsNotFound(err))
+	assert.True(t, errors.Is(err, backlog.NoResourceError))
+}
@@ -1,14 +1,18 @@
 package backlog
 
 import (
+	"context"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 type (
-	ExportRole   = role
-	ExportOrder  = order
-	ExportFormat = format
+	ExportRole        = role
+	ExportOrder       = order
+	ExportFormat      = format
+	ExportIssueSort   = issueSort
+	ExportParentChild = parentChild
 )
 
 type (
@@ -24,8 +28,17 @@ var (
 	ExportClientGet       = (*Client).get
 	ExportClientPost      = (*Client).post
 	ExportClientPatch     = (*Client).patch
+	ExportClientPut       = (*Client).put
 	ExportClientDelete    = (*Client).delete
 	ExportClientUploade   = (*Client).uploade
+	ExportClientDownload  = (*Client).download
+)
+
+var (
+	ExportRetryPolicyShouldRetry = (*RetryPolicy).shouldRetry
+	ExportRetryPolicyBackoff     = (*RetryPolicy).backoff
+	ExportSleep                  = sleep
+	ExportUnifiedDiff            = unifiedDiff
 )
 
 var (
@@ -51,6 +64,14 @@ func (c *Client) ExportSetHTTPClient(httpClient *http.Client) {
 	c.httpClient = httpClient
 }
 
+func (c *Client) ExportRetryPolicy() *RetryPolicy {
+	return c.retry
+}
+
+func (c *Client) ExportSetSleep(sleep func(ctx context.Context, d time.Duration) error) {
+	c.sleep = sleep
+}
+
 func (c *Client) ExportToken() string {
 	return c.token
 }
@@ -79,6 +100,10 @@ func (s *CustomFieldService) ExportSetMethod(m *method) {
 	s.method = m
 }
 
+func (s *GitService) ExportSetMethod(m *method) {
+	s.method = m
+}
+
 func (s *IssueService) ExportSetMethod(m *method) {
 	s.method = m
 }
@@ -87,6 +112,14 @@ func (s *IssueAttachmentService) ExportSetMethod(m *method) {
 	s.method = m
 }
 
+func (s *IssueCommentService) ExportSetMethod(m *method) {
+	s.method = m
+}
+
+func (s *IssueTypeService) ExportSetMethod(m *method) {
+	s.method = m
+}
+
 func (s *PriorityService) ExportSetMethod(m *method) {
 	s.method = m
 }
@@ -111,6 +144,10 @@ func (s *PullRequestAttachmentService) ExportSetMethod(m *method) {
 	s.method = m
 }
 
+func (s *PullRequestCommentService) ExportSetMethod(m *method) {
+	s.method = m
+}
+
 func (s *ResolutionService) ExportSetMethod(m *method) {
 	s.method = m
 }
@@ -146,3 +183,38 @@ func (s *WikiService) ExportSetMethod(m *method) {
 func (s *WikiAttachmentService) ExportSetMethod(m *method) {
 	s.method = m
 }
+
+func (s *WikiHistoryService) ExportSetMethod(m *method) {
+	s.method = m
+}
+
+func (s *WikiStarService) ExportSetMethod(m *method) {
+	s.method = m
+}
+
+func (s *WikiTagService) ExportSetMethod(m *method) {
+	s.method = m
+}
+
+func (s *RateLimitService) ExportSetMethod(m *method) {
+	s.method = m
+}
+
+type ExportPageFetcher = pageFetcher
+
+const (
+	ExportPagingCursor = pagingCursor
+	ExportPagingOffset = pagingOffset
+)
+
+func ExportNewPager(ctx context.Context, mode pagingMode, fetch pageFetcher, count int) *pager {
+	return newPager(ctx, mode, fetch, []option{withCount(count)})
+}
+
+func (p *pager) ExportNextPage() bool {
+	return p.nextPage()
+}
+
+func (p *pager) ExportErr() error {
+	return p.err
+}
@@ -0,0 +1,33 @@
+package backlog
+
+import (
+	"io"
+	"mime"
+)
+
+// File is a downloaded file such as an attachment or an image.
+// It reads the content from the response body without buffering,
+// so it must be closed after use.
+type File struct {
+	io.ReadCloser
+
+	// Name is the file name in Content-Disposition header. It is empty if the header has no file name.
+	Name string
+	// ContentType is the value of Content-Type header.
+	ContentType string
+	// Size is the value of Content-Length header. It is -1 if the length is unknown.
+	Size int64
+}
+
+func newFile(resp *response) *File {
+	f := &File{
+		ReadCloser:  resp.Body,
+		ContentType: resp.Header.Get("Content-Type"),
+		Size:        resp.ContentLength,
+	}
+	if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil {
+		f.Name = params["filename"]
+	}
+
+	return f
+}
@@ -0,0 +1,80 @@
+package backlog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+func repositoryPath(target ProjectIDOrKeyGetter, repoIDOrName string) (string, error) {
+	if repoIDOrName == "" {
+		return "", errors.New("repoIDOrName must not be empty")
+	}
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return "", err
+	}
+	return "projects/" + projectIDOrKey + "/git/repositories/" + repoIDOrName, nil
+}
+
+// GitService has methods for Git repositories.
+type GitService struct {
+	method *method
+}
+
+// All returns a list of Git repositories in the project.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-list-of-git-repositories
+func (s *GitService) All(target ProjectIDOrKeyGetter) ([]*Repository, error) {
+	return s.AllContext(context.Background(), target)
+}
+
+// AllContext is like All but with the context.
+func (s *GitService) AllContext(ctx context.Context, target ProjectIDOrKeyGetter) ([]*Repository, error) {
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return nil, err
+	}
+	spath := "projects/" + projectIDOrKey + "/git/repositories"
+
+	resp, err := s.method.Get(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := []*Repository{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// One returns one of the Git repositories in the project by ID or name.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-git-repository
+func (s *GitService) One(target ProjectIDOrKeyGetter, repoIDOrName string) (*Repository, error) {
+	return s.OneContext(context.Background(), target, repoIDOrName)
+}
+
+// OneContext is like One but with the context.
+func (s *GitService) OneContext(ctx context.Context, target ProjectIDOrKeyGetter, repoIDOrName string) (*Repository, error) {
+	spath, err := repositoryPath(target, repoIDOrName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.method.Get(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := Repository{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
@@ -0,0 +1,155 @@
+package backlog_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitService_All(t *testing.T) {
+	bj, err := os.Open("testdata/json/repository_list.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.GitService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/BLG/git/repositories", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	repos, err := s.All(backlog.ProjectKey("BLG"))
+	assert.NoError(t, err)
+	if assert.Len(t, repos, 2) {
+		assert.Equal(t, "app", repos[0].Name)
+		assert.True(t, repos[0].PushedAt.IsZero())
+		assert.Equal(t, "api", repos[1].Name)
+		assert.Equal(t, "xx@xx.git.backlogtool.com:/BLG/api.git", repos[1].SSHURL)
+		assert.Equal(t, time.Date(2013, time.June, 1, 10, 0, 0, 0, time.UTC), repos[1].PushedAt)
+	}
+}
+
+func TestGitService_All_invaliedProject(t *testing.T) {
+	s := &backlog.GitService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Get must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	repos, err := s.All(backlog.ProjectID(0))
+	assert.Nil(t, repos)
+	assert.Error(t, err)
+}
+
+func TestGitService_All_invaliedJson(t *testing.T) {
+	bj, err := os.Open("testdata/json/invalied.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.GitService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+
+	repos, err := s.All(backlog.ProjectKey("BLG"))
+	assert.Nil(t, repos)
+	assert.Error(t, err)
+}
+
+func TestGitService_One(t *testing.T) {
+	bj, err := os.Open("testdata/json/repository.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.GitService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/151/git/repositories/app", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	repo, err := s.One(backlog.ProjectID(151), "app")
+	assert.NoError(t, err)
+	if assert.NotNil(t, repo) {
+		assert.Equal(t, 1, repo.ID)
+		assert.Equal(t, 151, repo.ProjectID)
+		assert.Equal(t, "https://xx.backlogtool.com/git/BLG/app.git", repo.HTTPURL)
+		assert.Equal(t, "admin", repo.CreatedUser.Name)
+	}
+}
+
+func TestGitService_One_param_error(t *testing.T) {
+	cases := map[string]struct {
+		target       backlog.ProjectIDOrKeyGetter
+		repoIDOrName string
+	}{
+		"invalied_project": {
+			target:       backlog.ProjectKey(""),
+			repoIDOrName: "app",
+		},
+		"empty_repository": {
+			target:       backlog.ProjectKey("BLG"),
+			repoIDOrName: "",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			s := &backlog.GitService{}
+			s.ExportSetMethod(&backlog.ExportMethod{
+				Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+					t.Error("s.method.Get must never be called")
+					return nil, errors.New("error")
+				},
+			})
+
+			repo, err := s.One(tc.target, tc.repoIDOrName)
+			assert.Nil(t, repo)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestGitService_One_clientError(t *testing.T) {
+	s := &backlog.GitService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			return nil, errors.New("error")
+		},
+	})
+
+	repo, err := s.One(backlog.ProjectKey("BLG"), "app")
+	assert.Nil(t, repo)
+	assert.Error(t, err)
+}
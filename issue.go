@@ -1,8 +1,329 @@
 package backlog
 
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
 // IssueService has methods for Issue.
 type IssueService struct {
 	method *method
 
 	Attachment *IssueAttachmentService
+	Comment    *IssueCommentService
+	ListOption *IssueListOptionService
+	Option     *IssueOptionService
+}
+
+func fetchIssueList(ctx context.Context, get clientGet, params *requestParams) ([]*Issue, error) {
+	resp, err := get(ctx, "issues", params)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := []*Issue{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// All returns a list of issues.
+//
+// This method supports options returned by methods in "*Client.Issue.ListOption".
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-issue-list
+func (s *IssueService) All(options ...IssueListOption) ([]*Issue, error) {
+	return s.AllContext(context.Background(), options...)
+}
+
+// AllContext is like All but with the context.
+func (s *IssueService) AllContext(ctx context.Context, options ...IssueListOption) ([]*Issue, error) {
+	params := newRequestParams()
+	for _, option := range options {
+		if err := option(params); err != nil {
+			return nil, err
+		}
+	}
+
+	return fetchIssueList(ctx, s.method.Get, params)
+}
+
+// Search returns a list of issues by keyword.
+//
+// This method supports options returned by methods in "*Client.Issue.ListOption".
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-issue-list
+func (s *IssueService) Search(keyword string, options ...IssueListOption) ([]*Issue, error) {
+	return s.SearchContext(context.Background(), keyword, options...)
+}
+
+// SearchContext is like Search but with the context.
+func (s *IssueService) SearchContext(ctx context.Context, keyword string, options ...IssueListOption) ([]*Issue, error) {
+	if keyword == "" {
+		return nil, errors.New("keyword is requierd")
+	}
+
+	options = append([]IssueListOption{IssueListOption(withKeyword(keyword))}, options...)
+	return s.AllContext(ctx, options...)
+}
+
+// Iterate returns an iterator of all issues.
+// It fetches pages by offset and count while the iteration continues.
+//
+// This method supports options returned by methods in "*Client.Issue.ListOption".
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-issue-list
+func (s *IssueService) Iterate(options ...IssueListOption) *IssueIterator {
+	return s.IterateContext(context.Background(), options...)
+}
+
+// IterateContext is like Iterate but with the context.
+func (s *IssueService) IterateContext(ctx context.Context, options ...IssueListOption) *IssueIterator {
+	return newIssueIterator(ctx, s.method.Get, options)
+}
+
+// Query returns a new query to search issues.
+func (s *IssueService) Query() *IssueQuery {
+	return newIssueQuery()
+}
+
+// resolveProjectID returns ID of the project by the key.
+func (s *IssueService) resolveProjectID(ctx context.Context, key ProjectKey) (int, error) {
+	resp, err := s.method.Get(ctx, "projects/"+string(key), nil)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	v := Project{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return 0, err
+	}
+
+	return v.ID, nil
+}
+
+// AllByQuery returns a list of issues matching the query.
+// Project keys in the query are resolved to IDs before searching.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-issue-list
+func (s *IssueService) AllByQuery(q *IssueQuery) ([]*Issue, error) {
+	return s.AllByQueryContext(context.Background(), q)
+}
+
+// AllByQueryContext is like AllByQuery but with the context.
+func (s *IssueService) AllByQueryContext(ctx context.Context, q *IssueQuery) ([]*Issue, error) {
+	params, err := q.params(ctx, s.resolveProjectID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return fetchIssueList(ctx, s.method.Get, params)
+}
+
+// Count returns the number of issues.
+//
+// This method supports options returned by methods in "*Client.Issue.ListOption".
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/count-issue
+func (s *IssueService) Count(options ...IssueListOption) (int, error) {
+	return s.CountContext(context.Background(), options...)
+}
+
+// CountContext is like Count but with the context.
+func (s *IssueService) CountContext(ctx context.Context, options ...IssueListOption) (int, error) {
+	params := newRequestParams()
+	for _, option := range options {
+		if err := option(params); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.count(ctx, params)
+}
+
+func (s *IssueService) count(ctx context.Context, params *requestParams) (int, error) {
+	resp, err := s.method.Get(ctx, "issues/count", params)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	v := map[string]int{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return 0, err
+	}
+
+	return v["count"], nil
+}
+
+// CountByQuery returns the number of issues matching the query.
+// Sorting and paging in the query are ignored.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/count-issue
+func (s *IssueService) CountByQuery(q *IssueQuery) (int, error) {
+	return s.CountByQueryContext(context.Background(), q)
+}
+
+// CountByQueryContext is like CountByQuery but with the context.
+func (s *IssueService) CountByQueryContext(ctx context.Context, q *IssueQuery) (int, error) {
+	params, err := q.params(ctx, s.resolveProjectID, true)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.count(ctx, params)
+}
+
+// One returns one of the issues by ID or key.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-issue
+func (s *IssueService) One(issueIDOrKey string) (*Issue, error) {
+	return s.OneContext(context.Background(), issueIDOrKey)
+}
+
+// OneContext is like One but with the context.
+func (s *IssueService) OneContext(ctx context.Context, issueIDOrKey string) (*Issue, error) {
+	if issueIDOrKey == "" {
+		return nil, errors.New("issueIDOrKey must not be empty")
+	}
+
+	spath := "issues/" + issueIDOrKey
+	resp, err := s.method.Get(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := Issue{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+// Create creates a new issue.
+//
+// This method supports options returned by methods in "*Client.Issue.Option".
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-issue
+func (s *IssueService) Create(projectID int, summary string, issueTypeID, priorityID int, options ...IssueOption) (*Issue, error) {
+	return s.CreateContext(context.Background(), projectID, summary, issueTypeID, priorityID, options...)
+}
+
+// CreateContext is like Create but with the context.
+func (s *IssueService) CreateContext(ctx context.Context, projectID int, summary string, issueTypeID, priorityID int, options ...IssueOption) (*Issue, error) {
+	if projectID < 1 {
+		return nil, fmt.Errorf("projectID must be 1 or more: %d", projectID)
+	}
+	if summary == "" {
+		return nil, errors.New("summary is requierd")
+	}
+	if issueTypeID < 1 {
+		return nil, fmt.Errorf("issueTypeID must be 1 or more: %d", issueTypeID)
+	}
+	if priorityID < 1 {
+		return nil, fmt.Errorf("priorityID must be 1 or more: %d", priorityID)
+	}
+
+	params := newRequestParams()
+	params.Set("projectId", strconv.Itoa(projectID))
+	params.Set("summary", summary)
+	params.Set("issueTypeId", strconv.Itoa(issueTypeID))
+	params.Set("priorityId", strconv.Itoa(priorityID))
+
+	for _, option := range options {
+		if err := option(params); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.method.Post(ctx, "issues", params)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := Issue{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+// Update updates an issue.
+//
+// This method supports options returned by methods in "*Client.Issue.Option".
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-issue
+func (s *IssueService) Update(issueIDOrKey string, options ...IssueOption) (*Issue, error) {
+	return s.UpdateContext(context.Background(), issueIDOrKey, options...)
+}
+
+// UpdateContext is like Update but with the context.
+func (s *IssueService) UpdateContext(ctx context.Context, issueIDOrKey string, options ...IssueOption) (*Issue, error) {
+	if issueIDOrKey == "" {
+		return nil, errors.New("issueIDOrKey must not be empty")
+	}
+
+	if options == nil {
+		return nil, errors.New("requires one or more options")
+	}
+
+	params := newRequestParams()
+	for _, option := range options {
+		if err := option(params); err != nil {
+			return nil, err
+		}
+	}
+
+	spath := "issues/" + issueIDOrKey
+	resp, err := s.method.Patch(ctx, spath, params)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := Issue{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+// Delete deletes an issue.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-issue
+func (s *IssueService) Delete(issueIDOrKey string) (*Issue, error) {
+	return s.DeleteContext(context.Background(), issueIDOrKey)
+}
+
+// DeleteContext is like Delete but with the context.
+func (s *IssueService) DeleteContext(ctx context.Context, issueIDOrKey string) (*Issue, error) {
+	if issueIDOrKey == "" {
+		return nil, errors.New("issueIDOrKey must not be empty")
+	}
+
+	spath := "issues/" + issueIDOrKey
+	resp, err := s.method.Delete(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := Issue{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
 }
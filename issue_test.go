@@ -0,0 +1,637 @@
+package backlog_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueService_All(t *testing.T) {
+	bj, err := os.Open("testdata/json/issue_list.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	want := struct {
+		spath        string
+		projectID    []string
+		statusID     []string
+		sort         string
+		order        string
+		createdSince string
+		parentChild  string
+	}{
+		spath:        "issues",
+		projectID:    []string{"1", "2"},
+		statusID:     []string{"1"},
+		sort:         "updated",
+		order:        "desc",
+		createdSince: "2020-01-02",
+		parentChild:  "2",
+	}
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, want.spath, spath)
+			v := *params.ExportURLValues()
+			assert.Equal(t, want.projectID, v["projectId[]"])
+			assert.Equal(t, want.statusID, v["statusId[]"])
+			assert.Equal(t, want.sort, params.Get("sort"))
+			assert.Equal(t, want.order, params.Get("order"))
+			assert.Equal(t, want.createdSince, params.Get("createdSince"))
+			assert.Equal(t, want.parentChild, params.Get("parentChild"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	o := s.ListOption
+	issues, err := s.All(
+		o.WithProjectIDs([]int{1, 2}),
+		o.WithStatusIDs([]int{1}),
+		o.WithSort(backlog.SortUpdated),
+		o.WithOrder(backlog.OrderDesc),
+		o.WithCreatedSince(backlog.NewDate(2020, time.January, 2)),
+		o.WithParentChild(backlog.ParentChildChild),
+	)
+	assert.NoError(t, err)
+	assert.Len(t, issues, 2)
+	assert.Equal(t, "BLG-1", issues[0].IssueKey)
+	assert.Equal(t, 1.5, issues[0].EstimatedHours)
+	assert.Equal(t, 1, issues[1].ParentIssueID)
+	if assert.Len(t, issues[0].Category, 1) {
+		assert.Equal(t, "Development", issues[0].Category[0].Name)
+	}
+	if assert.Len(t, issues[0].Versions, 1) {
+		assert.Equal(t, "v1.0", issues[0].Versions[0].Name)
+	}
+	if assert.Len(t, issues[0].Milestone, 1) {
+		assert.Equal(t, 30, issues[0].Milestone[0].ID)
+	}
+	assert.Empty(t, issues[1].Category)
+	assert.Empty(t, issues[1].Versions)
+	assert.Empty(t, issues[1].Milestone)
+}
+
+func TestIssueService_All_option_error(t *testing.T) {
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Get must never be called")
+			return nil, errors.New("error")
+		},
+	})
+	o := s.ListOption
+	issues, err := s.All(o.WithStatusIDs([]int{1, 0}))
+	assert.Nil(t, issues)
+	assert.Error(t, err)
+}
+
+func TestIssueService_All_clientError(t *testing.T) {
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			return nil, errors.New("error")
+		},
+	})
+	issues, err := s.All()
+	assert.Nil(t, issues)
+	assert.Error(t, err)
+}
+
+func TestIssueService_All_invaliedJson(t *testing.T) {
+	bj, err := os.Open("testdata/json/invalied.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	issues, err := s.All()
+	assert.Nil(t, issues)
+	assert.Error(t, err)
+}
+
+func TestIssueService_Search(t *testing.T) {
+	bj, err := os.Open("testdata/json/issue_list.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	want := struct {
+		spath     string
+		keyword   string
+		projectID []string
+	}{
+		spath:     "issues",
+		keyword:   "crash",
+		projectID: []string{"1"},
+	}
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, want.spath, spath)
+			assert.Equal(t, want.keyword, params.Get("keyword"))
+			v := *params.ExportURLValues()
+			assert.Equal(t, want.projectID, v["projectId[]"])
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	issues, err := s.Search(want.keyword, s.ListOption.WithProjectIDs([]int{1}))
+	assert.NoError(t, err)
+	assert.Len(t, issues, 2)
+}
+
+func TestIssueService_Search_param_error(t *testing.T) {
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Get must never be called")
+			return nil, errors.New("error")
+		},
+	})
+	issues, err := s.Search("")
+	assert.Nil(t, issues)
+	assert.Error(t, err)
+}
+
+func TestIssueService_Iterate(t *testing.T) {
+	calls := []string{}
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "issues", spath)
+			calls = append(calls, params.Encode())
+
+			offset, _ := strconv.Atoi(params.Get("offset"))
+			count, _ := strconv.Atoi(params.Get("count"))
+			issues := []*backlog.Issue{}
+			for id := offset + 1; id <= 5 && len(issues) < count; id++ {
+				issues = append(issues, &backlog.Issue{ID: id})
+			}
+
+			bs, _ := json.Marshal(issues)
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(string(bs))),
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+
+	o := s.ListOption
+	it := s.Iterate(o.WithCount(2), o.WithProjectIDs([]int{1}))
+	ids := []int{}
+	for it.Next() {
+		ids = append(ids, it.Value().ID)
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, ids)
+	assert.Equal(t, []string{
+		"count=2&projectId%5B%5D=1",
+		"count=2&offset=2&projectId%5B%5D=1",
+		"count=2&offset=4&projectId%5B%5D=1",
+	}, calls)
+}
+
+func TestIssueService_Iterate_invalidOption(t *testing.T) {
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Get must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	it := s.Iterate(s.ListOption.WithOffset(-1))
+	assert.False(t, it.Next())
+	assert.Error(t, it.Err())
+}
+
+func TestIssueService_Count(t *testing.T) {
+	body := ioutil.NopCloser(strings.NewReader(`{"count":42}`))
+	want := struct {
+		spath      string
+		assigneeID []string
+		count      int
+	}{
+		spath:      "issues/count",
+		assigneeID: []string{"3"},
+		count:      42,
+	}
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, want.spath, spath)
+			v := *params.ExportURLValues()
+			assert.Equal(t, want.assigneeID, v["assigneeId[]"])
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       body,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	count, err := s.Count(s.ListOption.WithAssigneeIDs([]int{3}))
+	assert.NoError(t, err)
+	assert.Equal(t, want.count, count)
+}
+
+func TestIssueService_Count_option_error(t *testing.T) {
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Get must never be called")
+			return nil, errors.New("error")
+		},
+	})
+	count, err := s.Count(s.ListOption.WithKeyword(""))
+	assert.Zero(t, count)
+	assert.Error(t, err)
+}
+
+func TestIssueService_Count_clientError(t *testing.T) {
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			return nil, errors.New("error")
+		},
+	})
+	count, err := s.Count()
+	assert.Zero(t, count)
+	assert.Error(t, err)
+}
+
+func TestIssueService_One(t *testing.T) {
+	bj, err := os.Open("testdata/json/issue.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	issueKey := "BLG-1"
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "issues/"+issueKey, spath)
+			assert.Nil(t, params)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	issue, err := s.One(issueKey)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, issue.ID)
+	assert.Equal(t, issueKey, issue.IssueKey)
+	assert.Equal(t, "Task", issue.IssueType.Name)
+	assert.Equal(t, "Open", issue.Status.Name)
+	assert.Equal(t, "eguchi", issue.Assignee.UserID)
+	if assert.Len(t, issue.Category, 1) {
+		assert.Equal(t, 11, issue.Category[0].ID)
+	}
+	if assert.Len(t, issue.Versions, 1) {
+		assert.Equal(t, 3, issue.Versions[0].ID)
+	}
+	if assert.Len(t, issue.Milestone, 1) {
+		assert.Equal(t, "wait for release", issue.Milestone[0].Name)
+	}
+	assert.Len(t, issue.Attachments, 1)
+	cost, err := issue.CustomFields[0].AsNumber()
+	assert.NoError(t, err)
+	assert.Equal(t, 4.5, cost)
+}
+
+func TestIssueService_One_param_error(t *testing.T) {
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Get must never be called")
+			return nil, errors.New("error")
+		},
+	})
+	issue, err := s.One("")
+	assert.Nil(t, issue)
+	assert.Error(t, err)
+}
+
+func TestIssueService_One_invaliedJson(t *testing.T) {
+	bj, err := os.Open("testdata/json/invalied.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	issue, err := s.One("BLG-1")
+	assert.Nil(t, issue)
+	assert.Error(t, err)
+}
+
+func TestIssueService_Create(t *testing.T) {
+	bj, err := os.Open("testdata/json/issue.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	want := struct {
+		spath          string
+		projectID      string
+		summary        string
+		issueTypeID    string
+		priorityID     string
+		description    string
+		dueDate        string
+		estimatedHours string
+		notifiedUserID []string
+	}{
+		spath:          "issues",
+		projectID:      "1",
+		summary:        "first issue",
+		issueTypeID:    "2",
+		priorityID:     "3",
+		description:    "details",
+		dueDate:        "2020-12-31",
+		estimatedHours: "1.5",
+		notifiedUserID: []string{"4", "5"},
+	}
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, want.spath, spath)
+			assert.Equal(t, want.projectID, params.Get("projectId"))
+			assert.Equal(t, want.summary, params.Get("summary"))
+			assert.Equal(t, want.issueTypeID, params.Get("issueTypeId"))
+			assert.Equal(t, want.priorityID, params.Get("priorityId"))
+			assert.Equal(t, want.description, params.Get("description"))
+			assert.Equal(t, want.dueDate, params.Get("dueDate"))
+			assert.Equal(t, want.estimatedHours, params.Get("estimatedHours"))
+			v := *params.ExportURLValues()
+			assert.Equal(t, want.notifiedUserID, v["notifiedUserId[]"])
+
+			resp := &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	o := s.Option
+	issue, err := s.Create(1, "first issue", 2, 3,
+		o.WithDescription("details"),
+		o.WithDueDate(backlog.NewDate(2020, time.December, 31)),
+		o.WithEstimatedHours(1.5),
+		o.WithNotifiedUserIDs([]int{4, 5}),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "BLG-1", issue.IssueKey)
+}
+
+func TestIssueService_Create_param(t *testing.T) {
+	cases := map[string]struct {
+		projectID   int
+		summary     string
+		issueTypeID int
+		priorityID  int
+		wantError   bool
+	}{
+		"valid": {
+			projectID:   1,
+			summary:     "summary",
+			issueTypeID: 1,
+			priorityID:  1,
+			wantError:   false,
+		},
+		"projectID_zero": {
+			projectID:   0,
+			summary:     "summary",
+			issueTypeID: 1,
+			priorityID:  1,
+			wantError:   true,
+		},
+		"summary_empty": {
+			projectID:   1,
+			summary:     "",
+			issueTypeID: 1,
+			priorityID:  1,
+			wantError:   true,
+		},
+		"issueTypeID_zero": {
+			projectID:   1,
+			summary:     "summary",
+			issueTypeID: 0,
+			priorityID:  1,
+			wantError:   true,
+		},
+		"priorityID_zero": {
+			projectID:   1,
+			summary:     "summary",
+			issueTypeID: 1,
+			priorityID:  0,
+			wantError:   true,
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			s := &backlog.IssueService{}
+			s.ExportSetMethod(&backlog.ExportMethod{
+				Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+					if tc.wantError {
+						t.Error("s.method.Post must never be called")
+					}
+					return nil, errors.New("error")
+				},
+			})
+
+			if _, err := s.Create(tc.projectID, tc.summary, tc.issueTypeID, tc.priorityID); tc.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.EqualError(t, err, "error")
+			}
+		})
+	}
+}
+
+func TestIssueService_Create_option_error(t *testing.T) {
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Post must never be called")
+			return nil, errors.New("error")
+		},
+	})
+	issue, err := s.Create(1, "summary", 1, 1, s.Option.WithEstimatedHours(-1))
+	assert.Nil(t, issue)
+	assert.Error(t, err)
+}
+
+func TestIssueService_Update(t *testing.T) {
+	bj, err := os.Open("testdata/json/issue.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	want := struct {
+		spath      string
+		statusID   string
+		assigneeID string
+		comment    string
+	}{
+		spath:      "issues/BLG-1",
+		statusID:   "4",
+		assigneeID: "2",
+		comment:    "fixed",
+	}
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, want.spath, spath)
+			assert.Equal(t, want.statusID, params.Get("statusId"))
+			assert.Equal(t, want.assigneeID, params.Get("assigneeId"))
+			assert.Equal(t, want.comment, params.Get("comment"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	o := s.Option
+	issue, err := s.Update("BLG-1", o.WithStatusID(4), o.WithAssigneeID(2), o.WithComment("fixed"))
+	assert.NoError(t, err)
+	assert.Equal(t, "BLG-1", issue.IssueKey)
+}
+
+func TestIssueService_Update_param_error(t *testing.T) {
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Patch must never be called")
+			return nil, errors.New("error")
+		},
+	})
+	issue, err := s.Update("", s.Option.WithStatusID(1))
+	assert.Nil(t, issue)
+	assert.Error(t, err)
+}
+
+func TestIssueService_Update_option_required(t *testing.T) {
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Patch must never be called")
+			return nil, errors.New("error")
+		},
+	})
+	issue, err := s.Update("BLG-1")
+	assert.Nil(t, issue)
+	assert.Error(t, err)
+}
+
+func TestIssueService_Update_option_error(t *testing.T) {
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Patch must never be called")
+			return nil, errors.New("error")
+		},
+	})
+	issue, err := s.Update("BLG-1", s.Option.WithStatusID(0))
+	assert.Nil(t, issue)
+	assert.Error(t, err)
+}
+
+func TestIssueService_Delete(t *testing.T) {
+	bj, err := os.Open("testdata/json/issue.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "issues/1", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	issue, err := s.Delete("1")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, issue.ID)
+}
+
+func TestIssueService_Delete_param_error(t *testing.T) {
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Delete must never be called")
+			return nil, errors.New("error")
+		},
+	})
+	issue, err := s.Delete("")
+	assert.Nil(t, issue)
+	assert.Error(t, err)
+}
+
+func TestIssueService_Delete_clientError(t *testing.T) {
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			return nil, errors.New("error")
+		},
+	})
+	issue, err := s.Delete("BLG-1")
+	assert.Nil(t, issue)
+	assert.Error(t, err)
+}
@@ -0,0 +1,160 @@
+package backlog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+func decodeIssueType(resp *response) (*IssueType, error) {
+	defer resp.Body.Close()
+
+	v := IssueType{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+// IssueTypeService has methods for IssueType.
+type IssueTypeService struct {
+	method *method
+
+	Option *IssueTypeOptionService
+}
+
+// All returns a list of issue types in the project.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-issue-type-list
+func (s *IssueTypeService) All(target ProjectIDOrKeyGetter) ([]*IssueType, error) {
+	return s.AllContext(context.Background(), target)
+}
+
+// AllContext is like All but with the context.
+func (s *IssueTypeService) AllContext(ctx context.Context, target ProjectIDOrKeyGetter) ([]*IssueType, error) {
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return nil, err
+	}
+	spath := "projects/" + projectIDOrKey + "/issueTypes"
+
+	resp, err := s.method.Get(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := []*IssueType{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// Create creates a new issue type in the project.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-issue-type
+func (s *IssueTypeService) Create(target ProjectIDOrKeyGetter, name, color string) (*IssueType, error) {
+	return s.CreateContext(context.Background(), target, name, color)
+}
+
+// CreateContext is like Create but with the context.
+func (s *IssueTypeService) CreateContext(ctx context.Context, target ProjectIDOrKeyGetter, name, color string) (*IssueType, error) {
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return nil, err
+	}
+	spath := "projects/" + projectIDOrKey + "/issueTypes"
+
+	params := newRequestParams()
+	for _, option := range []option{withName(name), withIssueTypeColor(color)} {
+		if err := option(params); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.method.Post(ctx, spath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeIssueType(resp)
+}
+
+// Update updates the issue type in the project.
+//
+// This method supports options returned by methods in "*Client.Project.IssueType.Option".
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-issue-type
+func (s *IssueTypeService) Update(target ProjectIDOrKeyGetter, issueTypeID int, options ...IssueTypeOption) (*IssueType, error) {
+	return s.UpdateContext(context.Background(), target, issueTypeID, options...)
+}
+
+// UpdateContext is like Update but with the context.
+func (s *IssueTypeService) UpdateContext(ctx context.Context, target ProjectIDOrKeyGetter, issueTypeID int, options ...IssueTypeOption) (*IssueType, error) {
+	if issueTypeID < 1 {
+		return nil, fmt.Errorf("issueTypeID must be 1 or more: %d", issueTypeID)
+	}
+	if options == nil {
+		return nil, errors.New("requires one or more options")
+	}
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return nil, err
+	}
+	spath := "projects/" + projectIDOrKey + "/issueTypes/" + strconv.Itoa(issueTypeID)
+
+	params := newRequestParams()
+	for _, option := range options {
+		if err := option(params); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.method.Patch(ctx, spath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeIssueType(resp)
+}
+
+// Delete deletes the issue type in the project.
+// Issues of the issue type are changed to the substitute issue type.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-issue-type
+func (s *IssueTypeService) Delete(target ProjectIDOrKeyGetter, issueTypeID, substituteIssueTypeID int) (*IssueType, error) {
+	return s.DeleteContext(context.Background(), target, issueTypeID, substituteIssueTypeID)
+}
+
+// DeleteContext is like Delete but with the context.
+func (s *IssueTypeService) DeleteContext(ctx context.Context, target ProjectIDOrKeyGetter, issueTypeID, substituteIssueTypeID int) (*IssueType, error) {
+	if issueTypeID < 1 {
+		return nil, fmt.Errorf("issueTypeID must be 1 or more: %d", issueTypeID)
+	}
+	if substituteIssueTypeID < 1 {
+		return nil, fmt.Errorf("substituteIssueTypeID must be 1 or more: %d", substituteIssueTypeID)
+	}
+	if issueTypeID == substituteIssueTypeID {
+		return nil, errors.New("substituteIssueTypeID must be different from issueTypeID")
+	}
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return nil, err
+	}
+	spath := "projects/" + projectIDOrKey + "/issueTypes/" + strconv.Itoa(issueTypeID)
+
+	params := newRequestParams()
+	params.Set("substituteIssueTypeId", strconv.Itoa(substituteIssueTypeID))
+
+	resp, err := s.method.Delete(ctx, spath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeIssueType(resp)
+}
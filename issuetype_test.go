@@ -0,0 +1,186 @@
+package backlog_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueTypeService_All(t *testing.T) {
+	bj, err := os.Open("testdata/json/issue_type_list.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.IssueTypeService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/issueTypes", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	issueTypes, err := s.All(backlog.ProjectKey("TEST"))
+	assert.NoError(t, err)
+	assert.Len(t, issueTypes, 2)
+	assert.Equal(t, "Bug", issueTypes[0].Name)
+	assert.Equal(t, "#7ea800", issueTypes[1].Color)
+}
+
+func TestIssueTypeService_Create(t *testing.T) {
+	bj, err := os.Open("testdata/json/issue_type.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.IssueTypeService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/issueTypes", spath)
+			assert.Equal(t, "Task", params.Get("name"))
+			assert.Equal(t, "#7ea800", params.Get("color"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	issueType, err := s.Create(backlog.ProjectKey("TEST"), "Task", "#7ea800")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, issueType.ID)
+}
+
+func TestIssueTypeService_Create_param_error(t *testing.T) {
+	s := &backlog.IssueTypeService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Post must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	issueType, err := s.Create(backlog.ProjectKey("TEST"), "", "#7ea800")
+	assert.Nil(t, issueType)
+	assert.Error(t, err)
+
+	// Status colors can not be used for issue types.
+	issueType, err = s.Create(backlog.ProjectKey("TEST"), "Task", "#ea2c00")
+	assert.Nil(t, issueType)
+	assert.Error(t, err)
+
+	issueType, err = s.Create(backlog.ProjectKey(""), "Task", "#7ea800")
+	assert.Nil(t, issueType)
+	assert.Error(t, err)
+}
+
+func TestIssueTypeService_Update(t *testing.T) {
+	bj, err := os.Open("testdata/json/issue_type.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.IssueTypeService{
+		Option: &backlog.IssueTypeOptionService{},
+	}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/issueTypes/2", spath)
+			assert.Equal(t, "", params.Get("name"))
+			assert.Equal(t, "#ff9200", params.Get("color"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	issueType, err := s.Update(backlog.ProjectKey("TEST"), 2, s.Option.WithColor("#ff9200"))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, issueType.ID)
+}
+
+func TestIssueTypeService_Update_param_error(t *testing.T) {
+	s := &backlog.IssueTypeService{
+		Option: &backlog.IssueTypeOptionService{},
+	}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Patch must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	issueType, err := s.Update(backlog.ProjectKey("TEST"), 0, s.Option.WithName("Task"))
+	assert.Nil(t, issueType)
+	assert.Error(t, err)
+
+	issueType, err = s.Update(backlog.ProjectKey("TEST"), 2)
+	assert.Nil(t, issueType)
+	assert.Error(t, err)
+
+	issueType, err = s.Update(backlog.ProjectKey("TEST"), 2, s.Option.WithName(""))
+	assert.Nil(t, issueType)
+	assert.Error(t, err)
+}
+
+func TestIssueTypeService_Delete(t *testing.T) {
+	bj, err := os.Open("testdata/json/issue_type.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.IssueTypeService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/issueTypes/2", spath)
+			assert.Equal(t, "1", params.Get("substituteIssueTypeId"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	issueType, err := s.Delete(backlog.ProjectKey("TEST"), 2, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, issueType.ID)
+}
+
+func TestIssueTypeService_Delete_param_error(t *testing.T) {
+	s := &backlog.IssueTypeService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Delete must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	issueType, err := s.Delete(backlog.ProjectKey("TEST"), 0, 1)
+	assert.Nil(t, issueType)
+	assert.Error(t, err)
+
+	issueType, err = s.Delete(backlog.ProjectKey("TEST"), 2, 0)
+	assert.Nil(t, issueType)
+	assert.Error(t, err)
+
+	issueType, err = s.Delete(backlog.ProjectKey("TEST"), 2, 2)
+	assert.Nil(t, issueType)
+	assert.Error(t, err)
+}
@@ -0,0 +1,294 @@
+package backlog
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// defaultPageSize is the number of items fetched at once by iterators.
+const defaultPageSize = 100
+
+// pagingMode is how the pager walks pages.
+type pagingMode int
+
+const (
+	// pagingCursor walks pages by minId or maxId.
+	pagingCursor pagingMode = iota
+	// pagingOffset walks pages by offset and count.
+	pagingOffset
+)
+
+// pageFetcher fetches a page by the params.
+// It returns the number of fetched items and ID of the last item.
+type pageFetcher func(ctx context.Context, params *requestParams) (n, lastID int, err error)
+
+// pager keeps state to fetch pages one after another.
+type pager struct {
+	ctx    context.Context
+	mode   pagingMode
+	fetch  pageFetcher
+	params *requestParams
+	count  int
+	done   bool
+	err    error
+}
+
+// newPager returns pager whose first request is made by the options.
+func newPager(ctx context.Context, mode pagingMode, fetch pageFetcher, options []option) *pager {
+	p := &pager{
+		ctx:    ctx,
+		mode:   mode,
+		fetch:  fetch,
+		params: newRequestParams(),
+	}
+	if ctx == nil {
+		p.err = newClientError("ctx must not be nil")
+		return p
+	}
+	for _, option := range options {
+		if err := option(p.params); err != nil {
+			p.err = err
+			return p
+		}
+	}
+
+	p.count = defaultPageSize
+	if v := p.params.Get("count"); v != "" {
+		p.count, _ = strconv.Atoi(v)
+	} else {
+		p.params.Set("count", strconv.Itoa(p.count))
+	}
+
+	return p
+}
+
+// nextPage fetches the next page.
+// It returns false when there are no more pages or an error occurs.
+func (p *pager) nextPage() bool {
+	if p.err != nil || p.done {
+		return false
+	}
+
+	params := &requestParams{&url.Values{}}
+	for k, v := range *p.params.Values {
+		(*params.Values)[k] = append([]string(nil), v...)
+	}
+
+	n, lastID, err := p.fetch(p.ctx, params)
+	if err != nil {
+		p.err = err
+		return false
+	}
+	if n < p.count {
+		p.done = true
+	}
+	if n == 0 {
+		return false
+	}
+
+	switch p.mode {
+	case pagingCursor:
+		if p.params.Get("order") == string(OrderAsc) {
+			p.params.Set("minId", strconv.Itoa(lastID+1))
+		} else if lastID <= 1 {
+			p.done = true
+		} else {
+			p.params.Set("maxId", strconv.Itoa(lastID-1))
+		}
+	case pagingOffset:
+		offset, _ := strconv.Atoi(p.params.Get("offset"))
+		p.params.Set("offset", strconv.Itoa(offset+n))
+	}
+
+	return true
+}
+
+// ActivityIterator iterates activities over pages.
+//
+//	it := c.Space.Activity.Iterate()
+//	for it.Next() {
+//		activity := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type ActivityIterator struct {
+	pager *pager
+	items []*Activity
+	index int
+	value *Activity
+}
+
+func newActivityIterator(ctx context.Context, get clientGet, spath string, options []ActivityOption) *ActivityIterator {
+	it := &ActivityIterator{}
+	fetch := func(ctx context.Context, params *requestParams) (int, int, error) {
+		v, err := fetchActivityList(ctx, get, spath, params)
+		if err != nil {
+			return 0, 0, err
+		}
+		it.items, it.index = v, 0
+		if len(v) == 0 {
+			return 0, 0, nil
+		}
+		return len(v), v[len(v)-1].ID, nil
+	}
+
+	opts := make([]option, len(options))
+	for i, o := range options {
+		opts[i] = option(o)
+	}
+	it.pager = newPager(ctx, pagingCursor, fetch, opts)
+
+	return it
+}
+
+// Next advances the iterator to the next activity.
+// It returns false when the iteration stops.
+func (it *ActivityIterator) Next() bool {
+	for it.index >= len(it.items) {
+		if !it.pager.nextPage() {
+			it.value = nil
+			return false
+		}
+	}
+	it.value = it.items[it.index]
+	it.index++
+	return true
+}
+
+// Value returns the current activity.
+func (it *ActivityIterator) Value() *Activity {
+	return it.value
+}
+
+// Err returns the error which stopped the iteration.
+func (it *ActivityIterator) Err() error {
+	return it.pager.err
+}
+
+// IssueIterator iterates issues over pages.
+//
+//	it := c.Issue.Iterate(c.Issue.ListOption.WithProjectIDs([]int{1}))
+//	for it.Next() {
+//		issue := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type IssueIterator struct {
+	pager *pager
+	items []*Issue
+	index int
+	value *Issue
+}
+
+func newIssueIterator(ctx context.Context, get clientGet, options []IssueListOption) *IssueIterator {
+	it := &IssueIterator{}
+	fetch := func(ctx context.Context, params *requestParams) (int, int, error) {
+		v, err := fetchIssueList(ctx, get, params)
+		if err != nil {
+			return 0, 0, err
+		}
+		it.items, it.index = v, 0
+		if len(v) == 0 {
+			return 0, 0, nil
+		}
+		return len(v), v[len(v)-1].ID, nil
+	}
+
+	opts := make([]option, len(options))
+	for i, o := range options {
+		opts[i] = option(o)
+	}
+	it.pager = newPager(ctx, pagingOffset, fetch, opts)
+
+	return it
+}
+
+// Next advances the iterator to the next issue.
+// It returns false when the iteration stops.
+func (it *IssueIterator) Next() bool {
+	for it.index >= len(it.items) {
+		if !it.pager.nextPage() {
+			it.value = nil
+			return false
+		}
+	}
+	it.value = it.items[it.index]
+	it.index++
+	return true
+}
+
+// Value returns the current issue.
+func (it *IssueIterator) Value() *Issue {
+	return it.value
+}
+
+// Err returns the error which stopped the iteration.
+func (it *IssueIterator) Err() error {
+	return it.pager.err
+}
+
+// WikiHistoryIterator iterates versions of wiki over pages.
+//
+//	it := c.Wiki.History.Iterate(12345)
+//	for it.Next() {
+//		history := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type WikiHistoryIterator struct {
+	pager *pager
+	items []*WikiHistory
+	index int
+	value *WikiHistory
+}
+
+func newWikiHistoryIterator(ctx context.Context, get clientGet, spath string, options []WikiHistoryOption) *WikiHistoryIterator {
+	it := &WikiHistoryIterator{}
+	fetch := func(ctx context.Context, params *requestParams) (int, int, error) {
+		v, err := fetchWikiHistoryList(ctx, get, spath, params)
+		if err != nil {
+			return 0, 0, err
+		}
+		it.items, it.index = v, 0
+		if len(v) == 0 {
+			return 0, 0, nil
+		}
+		return len(v), v[len(v)-1].Version, nil
+	}
+
+	opts := make([]option, len(options))
+	for i, o := range options {
+		opts[i] = option(o)
+	}
+	it.pager = newPager(ctx, pagingCursor, fetch, opts)
+
+	return it
+}
+
+// Next advances the iterator to the next version.
+// It returns false when the iteration stops.
+func (it *WikiHistoryIterator) Next() bool {
+	for it.index >= len(it.items) {
+		if !it.pager.nextPage() {
+			it.value = nil
+			return false
+		}
+	}
+	it.value = it.items[it.index]
+	it.index++
+	return true
+}
+
+// Value returns the current version.
+func (it *WikiHistoryIterator) Value() *WikiHistory {
+	return it.value
+}
+
+// Err returns the error which stopped the iteration.
+func (it *WikiHistoryIterator) Err() error {
+	return it.pager.err
+}
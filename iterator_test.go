@@ -0,0 +1,212 @@
+package backlog_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+)
+
+// newActivityPageGet returns mock of Get which pages activities from maxID to 1.
+func newActivityPageGet(t *testing.T, maxID int, calls *[]string) func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+	return func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		*calls = append(*calls, params.Encode())
+
+		count, _ := strconv.Atoi(params.Get("count"))
+		activities := []*backlog.Activity{}
+		if params.Get("order") == "asc" {
+			min := 1
+			if v := params.Get("minId"); v != "" {
+				min, _ = strconv.Atoi(v)
+			}
+			for id := min; id <= maxID && len(activities) < count; id++ {
+				activities = append(activities, &backlog.Activity{ID: id})
+			}
+		} else {
+			max := maxID
+			if v := params.Get("maxId"); v != "" {
+				max, _ = strconv.Atoi(v)
+			}
+			for id := max; id >= 1 && len(activities) < count; id-- {
+				activities = append(activities, &backlog.Activity{ID: id})
+			}
+		}
+
+		bs, _ := json.Marshal(activities)
+		resp := &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(string(bs))),
+		}
+		return backlog.ExportNewResponse(resp), nil
+	}
+}
+
+func TestSpaceActivityService_Iterate(t *testing.T) {
+	calls := []string{}
+	s := &backlog.SpaceActivityService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: newActivityPageGet(t, 5, &calls),
+	})
+
+	o := &backlog.ActivityOptionService{}
+	it := s.Iterate(o.WithCount(2))
+	ids := []int{}
+	for it.Next() {
+		ids = append(ids, it.Value().ID)
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, ids)
+	assert.Equal(t, []string{"count=2", "count=2&maxId=3", "count=2&maxId=1"}, calls)
+	assert.Nil(t, it.Value())
+	assert.False(t, it.Next())
+}
+
+func TestSpaceActivityService_Iterate_asc(t *testing.T) {
+	calls := []string{}
+	s := &backlog.SpaceActivityService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: newActivityPageGet(t, 4, &calls),
+	})
+
+	o := &backlog.ActivityOptionService{}
+	it := s.Iterate(o.WithCount(2), o.WithOrder(backlog.OrderAsc))
+	ids := []int{}
+	for it.Next() {
+		ids = append(ids, it.Value().ID)
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []int{1, 2, 3, 4}, ids)
+	assert.Equal(t, []string{"count=2&order=asc", "count=2&minId=3&order=asc", "count=2&minId=5&order=asc"}, calls)
+}
+
+func TestSpaceActivityService_Iterate_defaultCount(t *testing.T) {
+	calls := []string{}
+	s := &backlog.SpaceActivityService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: newActivityPageGet(t, 150, &calls),
+	})
+
+	it := s.Iterate()
+	n := 0
+	for it.Next() {
+		n++
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, 150, n)
+	assert.Equal(t, []string{"count=100", "count=100&maxId=50"}, calls)
+}
+
+func TestSpaceActivityService_Iterate_error(t *testing.T) {
+	s := &backlog.SpaceActivityService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			return nil, errors.New("error")
+		},
+	})
+
+	it := s.Iterate()
+	assert.False(t, it.Next())
+	assert.EqualError(t, it.Err(), "error")
+}
+
+func TestSpaceActivityService_Iterate_invalidOption(t *testing.T) {
+	s := &backlog.SpaceActivityService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Get must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	o := &backlog.ActivityOptionService{}
+	it := s.Iterate(o.WithCount(0))
+	assert.False(t, it.Next())
+	assert.Error(t, it.Err())
+}
+
+func TestProjectActivityService_Iterate(t *testing.T) {
+	calls := []string{}
+	get := newActivityPageGet(t, 3, &calls)
+	s := &backlog.ProjectActivityService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/activities", spath)
+			return get(ctx, spath, params)
+		},
+	})
+
+	it := s.Iterate(backlog.ProjectKey("TEST"))
+	n := 0
+	for it.Next() {
+		n++
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, 3, n)
+
+	it = s.Iterate(backlog.ProjectKey(""))
+	assert.False(t, it.Next())
+	assert.Error(t, it.Err())
+}
+
+func TestUserActivityService_Iterate(t *testing.T) {
+	calls := []string{}
+	get := newActivityPageGet(t, 3, &calls)
+	s := &backlog.UserActivityService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "users/1/activities", spath)
+			return get(ctx, spath, params)
+		},
+	})
+
+	it := s.Iterate(1)
+	n := 0
+	for it.Next() {
+		n++
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, 3, n)
+
+	it = s.Iterate(0)
+	assert.False(t, it.Next())
+	assert.Error(t, it.Err())
+}
+
+func TestPager_offset(t *testing.T) {
+	offsets := []string{}
+	total := 5
+	fetch := func(ctx context.Context, params *backlog.ExportRequestParams) (int, int, error) {
+		offsets = append(offsets, params.Get("offset"))
+		offset, _ := strconv.Atoi(params.Get("offset"))
+		n := total - offset
+		if n > 2 {
+			n = 2
+		}
+		return n, 0, nil
+	}
+	p := backlog.ExportNewPager(context.Background(), backlog.ExportPagingOffset, fetch, 2)
+	pages := 0
+	for p.ExportNextPage() {
+		pages++
+	}
+	assert.NoError(t, p.ExportErr())
+	assert.Equal(t, 3, pages)
+	assert.Equal(t, []string{"", "2", "4"}, offsets)
+}
+
+func TestPager_nilContext(t *testing.T) {
+	fetch := func(ctx context.Context, params *backlog.ExportRequestParams) (int, int, error) {
+		t.Error("fetch must never be called")
+		return 0, 0, nil
+	}
+	p := backlog.ExportNewPager(nil, backlog.ExportPagingCursor, fetch, 2)
+	assert.False(t, p.ExportNextPage())
+	assert.Error(t, p.ExportErr())
+}
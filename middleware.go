@@ -0,0 +1,71 @@
+package backlog
+
+import (
+	"net/http"
+	"time"
+)
+
+// RoundTrip sends a http request and returns the response.
+type RoundTrip func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps RoundTrip to add behavior around every request.
+//
+// Every attempt of retry is sent through the middlewares.
+type Middleware func(next RoundTrip) RoundTrip
+
+// WithMiddleware returns option. the option adds middlewares to the client.
+// The first middleware is the outermost one.
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return func(c *Client) error {
+		for _, m := range middlewares {
+			if m == nil {
+				return newClientError("middleware must not be nil")
+			}
+		}
+		c.middlewares = append(c.middlewares, middlewares...)
+		return nil
+	}
+}
+
+// chainMiddlewares wraps the RoundTrip with the middlewares.
+func chainMiddlewares(rt RoundTrip, middlewares []Middleware) RoundTrip {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		rt = middlewares[i](rt)
+	}
+	return rt
+}
+
+// HeaderMiddleware returns middleware which sets the headers to every request.
+func HeaderMiddleware(header http.Header) Middleware {
+	return func(next RoundTrip) RoundTrip {
+		return func(req *http.Request) (*http.Response, error) {
+			for k, v := range header {
+				req.Header[k] = append([]string(nil), v...)
+			}
+			return next(req)
+		}
+	}
+}
+
+// LatencyHook receives the latency of a request.
+// The statusCode is 0 if the request failed without response.
+type LatencyHook func(method, path string, statusCode int, latency time.Duration)
+
+// LatencyMiddleware returns middleware which measures the latency of every request.
+// Use it to record metrics such as histograms.
+func LatencyMiddleware(hook LatencyHook) Middleware {
+	return func(next RoundTrip) RoundTrip {
+		return func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next(req)
+
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			hook(req.Method, req.URL.Path, statusCode, time.Since(start))
+
+			return resp, err
+		}
+	}
+}
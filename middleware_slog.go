@@ -0,0 +1,48 @@
+//go:build go1.21
+// +build go1.21
+
+package backlog
+
+import (
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// LoggingMiddleware returns middleware which writes every request to the structured logger.
+// The query of the request is never written not to leak the API key.
+func LoggingMiddleware(logger *slog.Logger) Middleware {
+	return func(next RoundTrip) RoundTrip {
+		return func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next(req)
+
+			attrs := []slog.Attr{
+				slog.String("method", req.Method),
+				slog.String("path", req.URL.Path),
+				slog.Duration("latency", time.Since(start)),
+			}
+			ctx := req.Context()
+
+			if err != nil {
+				logged := err
+				if ue, ok := err.(*url.Error); ok {
+					logged = ue.Err
+				}
+				attrs = append(attrs, slog.String("error", logged.Error()))
+				logger.LogAttrs(ctx, slog.LevelError, "backlog request failed", attrs...)
+				return resp, err
+			}
+
+			attrs = append(attrs, slog.Int("status", resp.StatusCode))
+			level := slog.LevelInfo
+			if resp.StatusCode >= 400 {
+				level = slog.LevelWarn
+			}
+			logger.LogAttrs(ctx, level, "backlog request", attrs...)
+
+			return resp, nil
+		}
+	}
+}
@@ -0,0 +1,53 @@
+//go:build go1.21
+// +build go1.21
+
+package backlog_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoggingMiddleware(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	c, _ := backlog.NewClient(
+		"https://test.backlog.com", "secret",
+		backlog.WithHTTPClient(NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+			switch req.URL.Path {
+			case "/api/v2/error":
+				return nil, errors.New("connection refused")
+			case "/api/v2/notfound":
+				return &http.Response{StatusCode: http.StatusNotFound, Body: http.NoBody}, nil
+			}
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		})),
+		backlog.WithMiddleware(backlog.LoggingMiddleware(logger)),
+	)
+
+	backlog.ExportClientGet(c, context.Background(), "spath", nil)
+	assert.Contains(t, buf.String(), "level=INFO")
+	assert.Contains(t, buf.String(), "method=GET")
+	assert.Contains(t, buf.String(), "path=/api/v2/spath")
+	assert.Contains(t, buf.String(), "status=200")
+
+	buf.Reset()
+	backlog.ExportClientGet(c, context.Background(), "notfound", nil)
+	assert.Contains(t, buf.String(), "level=WARN")
+	assert.Contains(t, buf.String(), "status=404")
+
+	buf.Reset()
+	_, err := backlog.ExportClientGet(c, context.Background(), "error", nil)
+	assert.Error(t, err)
+	assert.Contains(t, buf.String(), "level=ERROR")
+	assert.Contains(t, buf.String(), "connection refused")
+	assert.NotContains(t, buf.String(), "secret")
+}
@@ -0,0 +1,122 @@
+package backlog_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithMiddleware_nil(t *testing.T) {
+	c, err := backlog.NewClient("https://test.backlog.com", "test", backlog.WithMiddleware(nil))
+	assert.Error(t, err)
+	assert.Nil(t, c)
+}
+
+func TestWithMiddleware_order(t *testing.T) {
+	calls := []string{}
+	newMiddleware := func(name string) backlog.Middleware {
+		return func(next backlog.RoundTrip) backlog.RoundTrip {
+			return func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+"-before")
+				resp, err := next(req)
+				calls = append(calls, name+"-after")
+				return resp, err
+			}
+		}
+	}
+
+	c, _ := backlog.NewClient(
+		"https://test.backlog.com", "test",
+		backlog.WithHTTPClient(NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+			calls = append(calls, "transport")
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		})),
+		backlog.WithMiddleware(newMiddleware("first"), newMiddleware("second")),
+		backlog.WithMiddleware(newMiddleware("third")),
+	)
+
+	_, err := backlog.ExportClientGet(c, context.Background(), "spath", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"first-before", "second-before", "third-before",
+		"transport",
+		"third-after", "second-after", "first-after",
+	}, calls)
+}
+
+func TestWithMiddleware_shortCircuit(t *testing.T) {
+	c, _ := backlog.NewClient(
+		"https://test.backlog.com", "test",
+		backlog.WithHTTPClient(NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+			t.Error("httpClient.Do must never be called")
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		})),
+		backlog.WithMiddleware(func(next backlog.RoundTrip) backlog.RoundTrip {
+			return func(req *http.Request) (*http.Response, error) {
+				return nil, errors.New("denied")
+			}
+		}),
+	)
+
+	_, err := backlog.ExportClientGet(c, context.Background(), "spath", nil)
+	assert.EqualError(t, err, "denied")
+}
+
+func TestHeaderMiddleware(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-Request-Id", "abc")
+
+	c, _ := backlog.NewClient(
+		"https://test.backlog.com", "test",
+		backlog.WithHTTPClient(NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "abc", req.Header.Get("X-Request-Id"))
+			assert.Equal(t, "application/json", req.Header.Get("Accept"))
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		})),
+		backlog.WithMiddleware(backlog.HeaderMiddleware(header)),
+	)
+
+	_, err := backlog.ExportClientGet(c, context.Background(), "spath", nil)
+	assert.NoError(t, err)
+}
+
+func TestLatencyMiddleware(t *testing.T) {
+	type record struct {
+		method     string
+		path       string
+		statusCode int
+		latency    time.Duration
+	}
+	records := []record{}
+	hook := func(method, path string, statusCode int, latency time.Duration) {
+		records = append(records, record{method, path, statusCode, latency})
+	}
+
+	c, _ := backlog.NewClient(
+		"https://test.backlog.com", "test",
+		backlog.WithHTTPClient(NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+			if req.URL.Path == "/api/v2/error" {
+				return nil, errors.New("error")
+			}
+			time.Sleep(time.Millisecond)
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		})),
+		backlog.WithMiddleware(backlog.LatencyMiddleware(hook)),
+	)
+
+	backlog.ExportClientGet(c, context.Background(), "spath", nil)
+	backlog.ExportClientPost(c, context.Background(), "error", nil)
+
+	assert.Len(t, records, 2)
+	assert.Equal(t, http.MethodGet, records[0].method)
+	assert.Equal(t, "/api/v2/spath", records[0].path)
+	assert.Equal(t, http.StatusOK, records[0].statusCode)
+	assert.True(t, records[0].latency >= time.Millisecond)
+	assert.Equal(t, http.MethodPost, records[1].method)
+	assert.Equal(t, 0, records[1].statusCode)
+}
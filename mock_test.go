@@ -19,9 +19,7 @@ func NewHTTPClientMock(fn RoundTripFunc) *http.Client {
 }
 
 func NewClientMock(baseURL, token string, fn RoundTripFunc) *backlog.Client {
-	c, _ := backlog.NewClient(baseURL, token)
-	httpClient := NewHTTPClientMock(fn)
-	c.ExportSetHTTPClient(httpClient)
+	c, _ := backlog.NewClient(baseURL, token, backlog.WithHTTPClient(NewHTTPClientMock(fn)))
 
 	return c
 }
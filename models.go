@@ -1,6 +1,8 @@
 package backlog
 
 import (
+	"bytes"
+	"encoding/json"
 	"time"
 )
 
@@ -33,7 +35,7 @@ type Attachment struct {
 }
 
 // Category represents category of Backlog.
-type Category []struct {
+type Category struct {
 	ID           int    `json:"id,omitempty"`
 	Name         string `json:"name,omitempty"`
 	DisplayOrder int    `json:"displayOrder,omitempty"`
@@ -41,9 +43,62 @@ type Category []struct {
 
 // ChangeLog reprements one of ChangeLogs.
 type ChangeLog struct {
-	Field         string `json:"field,omitempty"`
-	NewValue      string `json:"newValue,omitempty"`
-	OriginalValue string `json:"originalValue,omitempty"`
+	Field            string                     `json:"field,omitempty"`
+	NewValue         string                     `json:"newValue,omitempty"`
+	OriginalValue    string                     `json:"originalValue,omitempty"`
+	AttachmentInfo   *ChangeLogAttachmentInfo   `json:"attachmentInfo,omitempty"`
+	AttributeInfo    *ChangeLogAttributeInfo    `json:"attributeInfo,omitempty"`
+	NotificationInfo *ChangeLogNotificationInfo `json:"notificationInfo,omitempty"`
+}
+
+// ChangeLogAttachmentInfo represents the attachment changed by ChangeLog.
+type ChangeLogAttachmentInfo struct {
+	ID   int    `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// ChangeLogAttributeInfo represents the custom field changed by ChangeLog.
+type ChangeLogAttributeInfo struct {
+	ID     int `json:"id,omitempty"`
+	TypeID int `json:"typeId,omitempty"`
+}
+
+// ChangeLogNotificationInfo represents the notification sent by ChangeLog.
+type ChangeLogNotificationInfo struct {
+	Type string `json:"type,omitempty"`
+}
+
+// Commit represents commit of Backlog git.
+// Backlog returns either an object or the commit ID only,
+// and the latter is decoded as a Commit which has only ID.
+type Commit struct {
+	ID          string    `json:"id,omitempty"`
+	Message     string    `json:"message,omitempty"`
+	AuthorName  string    `json:"authorName,omitempty"`
+	AuthorEmail string    `json:"authorEmail,omitempty"`
+	AuthoredAt  time.Time `json:"authoredAt,omitempty"`
+}
+
+// UnmarshalJSON decodes the commit object or the commit ID.
+func (c *Commit) UnmarshalJSON(b []byte) error {
+	if bytes.HasPrefix(b, []byte(`"`)) {
+		id := ""
+		if err := json.Unmarshal(b, &id); err != nil {
+			return err
+		}
+		*c = Commit{ID: id}
+		return nil
+	}
+
+	// commit avoids infinite recursion of UnmarshalJSON.
+	type commit Commit
+	v := commit{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*c = Commit(v)
+
+	return nil
 }
 
 // Comment reprements comment of Backlog.
@@ -54,7 +109,7 @@ type Comment struct {
 	CreatedUser   *User           `json:"createdUser,omitempty"`
 	Created       time.Time       `json:"created,omitempty"`
 	Updated       time.Time       `json:"updated,omitempty"`
-	Stars         *Star           `json:"stars,omitempty"`
+	Stars         []*Star         `json:"stars,omitempty"`
 	Notifications []*Notification `json:"notifications,omitempty"`
 }
 
@@ -67,6 +122,9 @@ type CustomField struct {
 	Required               bool               `json:"required,omitempty"`
 	ApplicableIssueTypeIDs []int              `json:"applicableIssueTypes,omitempty"`
 	AllowAddItem           bool               `json:"allowAddItem,omitempty"`
+	AllowInput             bool               `json:"allowInput,omitempty"`
+	Unit                   string             `json:"unit,omitempty"`
+	DisplayOrder           int                `json:"displayOrder,omitempty"`
 	Items                  []*CustomFieldItem `json:"items,omitempty"`
 }
 
@@ -102,9 +160,9 @@ type DiskUsageProject struct {
 
 // Error represents one of Backlog API response errors.
 type Error struct {
-	Message  string `json:"message,omitempty"`
-	Code     int    `json:"code,omitempty"`
-	MoreInfo string `json:"moreInfo,omitempty"`
+	Message  string    `json:"message,omitempty"`
+	Code     ErrorCode `json:"code,omitempty"`
+	MoreInfo string    `json:"moreInfo,omitempty"`
 }
 
 // Licence represents licence of space.
@@ -156,38 +214,39 @@ type Notification struct {
 	PullRequest         *PullRequest `json:"pullRequest,omitempty"`
 	PullRequestComment  *Comment     `json:"pullRequestComment,omitempty"`
 	Sender              *User        `json:"sender,omitempty"`
+	User                *User        `json:"user,omitempty"`
 	Created             time.Time    `json:"created,omitempty"`
 }
 
 // Issue represents Backlog Issue.
 type Issue struct {
-	ID             int            `json:"id,omitempty"`
-	ProjectID      int            `json:"projectId,omitempty"`
-	IssueKey       string         `json:"issueKey,omitempty"`
-	KeyID          int            `json:"keyId,omitempty"`
-	IssueType      *IssueType     `json:"issueType,omitempty"`
-	Summary        string         `json:"summary,omitempty"`
-	Description    string         `json:"description,omitempty"`
-	Resolutions    []*Resolution  `json:"resolutions,omitempty"`
-	Priority       *Priority      `json:"priority,omitempty"`
-	Status         *Status        `json:"status,omitempty"`
-	Assignee       *User          `json:"assignee,omitempty"`
-	Category       *Category      `json:"category,omitempty"`
-	Versions       *Version       `json:"versions,omitempty"`
-	Milestone      *Version       `json:"milestone,omitempty"`
-	StartDate      time.Time      `json:"startDate,omitempty"`
-	DueDate        time.Time      `json:"dueDate,omitempty"`
-	EstimatedHours int            `json:"estimatedHours,omitempty"`
-	ActualHours    int            `json:"actualHours,omitempty"`
-	ParentIssueID  int            `json:"parentIssueId,omitempty"`
-	CreatedUser    *User          `json:"createdUser,omitempty"`
-	Created        time.Time      `json:"created,omitempty"`
-	UpdatedUser    *User          `json:"updatedUser,omitempty"`
-	Updated        time.Time      `json:"updated,omitempty"`
-	CustomFields   []*CustomField `json:"customFields,omitempty"`
-	Attachments    []*Attachment  `json:"attachments,omitempty"`
-	SharedFiles    []*SharedFile  `json:"sharedFiles,omitempty"`
-	Stars          []*Star        `json:"stars,omitempty"`
+	ID             int                 `json:"id,omitempty"`
+	ProjectID      int                 `json:"projectId,omitempty"`
+	IssueKey       string              `json:"issueKey,omitempty"`
+	KeyID          int                 `json:"keyId,omitempty"`
+	IssueType      *IssueType          `json:"issueType,omitempty"`
+	Summary        string              `json:"summary,omitempty"`
+	Description    string              `json:"description,omitempty"`
+	Resolution     *Resolution         `json:"resolution,omitempty"`
+	Priority       *Priority           `json:"priority,omitempty"`
+	Status         *Status             `json:"status,omitempty"`
+	Assignee       *User               `json:"assignee,omitempty"`
+	Category       []*Category         `json:"category,omitempty"`
+	Versions       []*Version          `json:"versions,omitempty"`
+	Milestone      []*Version          `json:"milestone,omitempty"`
+	StartDate      Date                `json:"startDate,omitempty"`
+	DueDate        Date                `json:"dueDate,omitempty"`
+	EstimatedHours float64             `json:"estimatedHours,omitempty"`
+	ActualHours    float64             `json:"actualHours,omitempty"`
+	ParentIssueID  int                 `json:"parentIssueId,omitempty"`
+	CreatedUser    *User               `json:"createdUser,omitempty"`
+	Created        time.Time           `json:"created,omitempty"`
+	UpdatedUser    *User               `json:"updatedUser,omitempty"`
+	Updated        time.Time           `json:"updated,omitempty"`
+	CustomFields   []*CustomFieldValue `json:"customFields,omitempty"`
+	Attachments    []*Attachment       `json:"attachments,omitempty"`
+	SharedFiles    []*SharedFile       `json:"sharedFiles,omitempty"`
+	Stars          []*Star             `json:"stars,omitempty"`
 }
 
 // IssueType represents type of Issue.
@@ -230,8 +289,8 @@ type PullRequest struct {
 	Status       *Status       `json:"status,omitempty"`
 	Assignee     *User         `json:"assignee,omitempty"`
 	Issue        *Issue        `json:"issue,omitempty"`
-	BaseCommit   interface{}   `json:"baseCommit,omitempty"`
-	BranchCommit interface{}   `json:"branchCommit,omitempty"`
+	BaseCommit   *Commit       `json:"baseCommit,omitempty"`
+	BranchCommit *Commit       `json:"branchCommit,omitempty"`
 	CloseAt      time.Time     `json:"closeAt,omitempty"`
 	MergeAt      time.Time     `json:"mergeAt,omitempty"`
 	CreatedUser  *User         `json:"createdUser,omitempty"`
@@ -242,6 +301,43 @@ type PullRequest struct {
 	Stars        []*Star       `json:"stars,omitempty"`
 }
 
+// IsMerged reports whether the pull request has been merged.
+func (pr *PullRequest) IsMerged() bool {
+	if pr.Status != nil && pr.Status.ID == PullRequestStatusMerged {
+		return true
+	}
+	return !pr.MergeAt.IsZero()
+}
+
+// IsClosed reports whether the pull request has been closed.
+// Merged pull requests are also closed.
+func (pr *PullRequest) IsClosed() bool {
+	if pr.Status != nil && (pr.Status.ID == PullRequestStatusClosed || pr.Status.ID == PullRequestStatusMerged) {
+		return true
+	}
+	return !pr.CloseAt.IsZero() || !pr.MergeAt.IsZero()
+}
+
+// RateLimit represents rate limit of Backlog API.
+type RateLimit struct {
+	Limit     int   `json:"limit,omitempty"`
+	Remaining int   `json:"remaining,omitempty"`
+	Reset     int64 `json:"reset,omitempty"`
+}
+
+// ResetTime returns the time when the rate limit is reset.
+func (r *RateLimit) ResetTime() time.Time {
+	return time.Unix(r.Reset, 0)
+}
+
+// RateLimitStatus represents rate limits for each type of API.
+type RateLimitStatus struct {
+	Read   *RateLimit `json:"read,omitempty"`
+	Update *RateLimit `json:"update,omitempty"`
+	Search *RateLimit `json:"search,omitempty"`
+	Icon   *RateLimit `json:"icon,omitempty"`
+}
+
 // Repository represents repository of Backlog git.
 type Repository struct {
 	ID           int       `json:"id,omitempty"`
@@ -309,8 +405,11 @@ type Star struct {
 
 // Status represents any status.
 type Status struct {
-	ID   int    `json:"id,omitempty"`
-	Name string `json:"name,omitempty"`
+	ID           int    `json:"id,omitempty"`
+	ProjectID    int    `json:"projectId,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Color        string `json:"color,omitempty"`
+	DisplayOrder int    `json:"displayOrder,omitempty"`
 }
 
 // Tag represents one of tags in Wiki.
@@ -343,14 +442,14 @@ type User struct {
 
 // Version represents any version.
 type Version struct {
-	ID             int       `json:"id,omitempty"`
-	ProjectID      int       `json:"projectId,omitempty"`
-	Name           string    `json:"name,omitempty"`
-	Description    string    `json:"description,omitempty"`
-	StartDate      time.Time `json:"startDate,omitempty"`
-	ReleaseDueDate time.Time `json:"releaseDueDate,omitempty"`
-	Archived       bool      `json:"archived,omitempty"`
-	DisplayOrder   int       `json:"displayOrder,omitempty"`
+	ID             int    `json:"id,omitempty"`
+	ProjectID      int    `json:"projectId,omitempty"`
+	Name           string `json:"name,omitempty"`
+	Description    string `json:"description,omitempty"`
+	StartDate      Date   `json:"startDate,omitempty"`
+	ReleaseDueDate Date   `json:"releaseDueDate,omitempty"`
+	Archived       bool   `json:"archived,omitempty"`
+	DisplayOrder   int    `json:"displayOrder,omitempty"`
 }
 
 // WatchingItem represents an item of watching list.
@@ -431,6 +530,39 @@ func (o order) String() string {
 	}
 }
 
+type issueSort string
+
+func (s issueSort) valid() bool {
+	switch s {
+	case SortIssueType, SortCategory, SortVersion, SortMilestone, SortSummary,
+		SortStatus, SortPriority, SortAttachment, SortSharedFile, SortCreated,
+		SortCreatedUser, SortUpdated, SortUpdatedUser, SortAssignee, SortStartDate,
+		SortDueDate, SortEstimatedHours, SortActualHours, SortChildIssue:
+		return true
+	default:
+		return false
+	}
+}
+
+type parentChild int
+
+func (p parentChild) String() string {
+	switch p {
+	case ParentChildAll:
+		return "All"
+	case ParentChildExcludeChild:
+		return "ExcludeChild"
+	case ParentChildChild:
+		return "Child"
+	case ParentChildNeither:
+		return "Neither"
+	case ParentChildParent:
+		return "Parent"
+	default:
+		return "unknown"
+	}
+}
+
 type role int
 
 func (r role) String() string {
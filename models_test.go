@@ -1,7 +1,10 @@
 package backlog_test
 
 import (
+	"encoding/json"
+	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/nattokin/go-backlog"
 	"github.com/stretchr/testify/assert"
@@ -100,3 +103,279 @@ func TestRole_String(t *testing.T) {
 		})
 	}
 }
+
+func TestParentChild_String(t *testing.T) {
+	cases := map[string]struct {
+		parentChild backlog.ExportParentChild
+		want        string
+	}{
+		"All": {
+			parentChild: backlog.ParentChildAll,
+			want:        "All",
+		},
+		"ExcludeChild": {
+			parentChild: backlog.ParentChildExcludeChild,
+			want:        "ExcludeChild",
+		},
+		"Child": {
+			parentChild: backlog.ParentChildChild,
+			want:        "Child",
+		},
+		"Neither": {
+			parentChild: backlog.ParentChildNeither,
+			want:        "Neither",
+		},
+		"Parent": {
+			parentChild: backlog.ParentChildParent,
+			want:        "Parent",
+		},
+		"unknown": {
+			parentChild: backlog.ExportParentChild(5),
+			want:        "unknown",
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, tc.parentChild.String(), tc.want)
+		})
+	}
+}
+
+func readIssue(t *testing.T, fpath string) *backlog.Issue {
+	b, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := backlog.Issue{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		t.Fatal(err)
+	}
+	return &v
+}
+
+func TestIssue_UnmarshalJSON_maximum(t *testing.T) {
+	issue := readIssue(t, "testdata/json/issue_maximum.json")
+
+	assert.Equal(t, "BLG-1", issue.IssueKey)
+	assert.Equal(t, "Fixed", issue.Resolution.Name)
+	assert.Equal(t, backlog.StatusResolved, issue.Status.ID)
+	if assert.Len(t, issue.Category, 2) {
+		assert.Equal(t, 12, issue.Category[0].ID)
+		assert.Equal(t, "Design", issue.Category[1].Name)
+		assert.Equal(t, 1, issue.Category[1].DisplayOrder)
+	}
+	if assert.Len(t, issue.Versions, 1) {
+		assert.Equal(t, "v1.0", issue.Versions[0].Name)
+		assert.Equal(t, backlog.NewDate(2019, time.October, 31), issue.Versions[0].ReleaseDueDate)
+	}
+	if assert.Len(t, issue.Milestone, 2) {
+		assert.True(t, issue.Milestone[0].StartDate.IsZero())
+		assert.True(t, issue.Milestone[1].Archived)
+	}
+	assert.Equal(t, 8.0, issue.EstimatedHours)
+	assert.Equal(t, 6.5, issue.ActualHours)
+	assert.Equal(t, 10, issue.ParentIssueID)
+	assert.Len(t, issue.CustomFields, 2)
+	assert.Len(t, issue.Attachments, 1)
+	assert.Len(t, issue.SharedFiles, 1)
+	assert.Len(t, issue.Stars, 1)
+}
+
+func TestIssue_UnmarshalJSON_minimum(t *testing.T) {
+	issue := readIssue(t, "testdata/json/issue_minimum.json")
+
+	assert.Equal(t, "BLG-2", issue.IssueKey)
+	assert.Nil(t, issue.Resolution)
+	assert.Nil(t, issue.Assignee)
+	assert.Empty(t, issue.Category)
+	assert.Empty(t, issue.Versions)
+	assert.Empty(t, issue.Milestone)
+	assert.True(t, issue.StartDate.IsZero())
+	assert.True(t, issue.DueDate.IsZero())
+	assert.Zero(t, issue.EstimatedHours)
+	assert.Zero(t, issue.ParentIssueID)
+	assert.True(t, issue.Updated.IsZero())
+}
+
+func TestIssue_roundTrip(t *testing.T) {
+	type ref struct {
+		ID   int
+		Name string
+	}
+	cases := map[string]struct {
+		fpath      string
+		resolution *ref
+		category   []ref
+		versions   []ref
+		milestone  []ref
+	}{
+		"maximum": {
+			fpath:      "testdata/json/issue_maximum.json",
+			resolution: &ref{ID: 0, Name: "Fixed"},
+			category:   []ref{{ID: 12, Name: "Development"}, {ID: 13, Name: "Design"}},
+			versions:   []ref{{ID: 3, Name: "v1.0"}},
+			milestone:  []ref{{ID: 30, Name: "wait for release"}, {ID: 31, Name: "sprint 1"}},
+		},
+		"minimum": {
+			fpath:     "testdata/json/issue_minimum.json",
+			category:  []ref{},
+			versions:  []ref{},
+			milestone: []ref{},
+		},
+		"issue": {
+			fpath:     "testdata/json/issue.json",
+			category:  []ref{{ID: 11, Name: "Development"}},
+			versions:  []ref{{ID: 3, Name: "v1.0"}},
+			milestone: []ref{{ID: 30, Name: "wait for release"}},
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			issue := readIssue(t, tc.fpath)
+			want, err := json.Marshal(issue)
+			if !assert.NoError(t, err) {
+				return
+			}
+			v := backlog.Issue{}
+			if !assert.NoError(t, json.Unmarshal(want, &v)) {
+				return
+			}
+			got, err := json.Marshal(&v)
+			if assert.NoError(t, err) {
+				assert.JSONEq(t, string(want), string(got))
+			}
+
+			for _, issue := range []*backlog.Issue{issue, &v} {
+				if tc.resolution == nil {
+					assert.Nil(t, issue.Resolution)
+				} else if assert.NotNil(t, issue.Resolution) {
+					assert.Equal(t, *tc.resolution, ref{ID: issue.Resolution.ID, Name: issue.Resolution.Name})
+				}
+
+				category := []ref{}
+				for _, c := range issue.Category {
+					category = append(category, ref{ID: c.ID, Name: c.Name})
+				}
+				assert.Equal(t, tc.category, category)
+
+				versions := []ref{}
+				for _, v := range issue.Versions {
+					versions = append(versions, ref{ID: v.ID, Name: v.Name})
+				}
+				assert.Equal(t, tc.versions, versions)
+
+				milestone := []ref{}
+				for _, v := range issue.Milestone {
+					milestone = append(milestone, ref{ID: v.ID, Name: v.Name})
+				}
+				assert.Equal(t, tc.milestone, milestone)
+			}
+		})
+	}
+}
+
+func TestIssue_MarshalJSON_arrays(t *testing.T) {
+	issue := readIssue(t, "testdata/json/issue_maximum.json")
+
+	b, err := json.Marshal(issue)
+	if !assert.NoError(t, err) {
+		return
+	}
+	v := map[string]json.RawMessage{}
+	if !assert.NoError(t, json.Unmarshal(b, &v)) {
+		return
+	}
+	for _, key := range []string{"category", "versions", "milestone"} {
+		if assert.Contains(t, v, key) {
+			assert.Equal(t, byte('['), v[key][0], key)
+		}
+	}
+}
+
+func TestVersion_UnmarshalJSON_dateOnly(t *testing.T) {
+	b := []byte(`{"id":4,"name":"v1.0","startDate":"2019-10-01","releaseDueDate":null}`)
+
+	v := backlog.Version{}
+	assert.NoError(t, json.Unmarshal(b, &v))
+	assert.Equal(t, backlog.NewDate(2019, time.October, 1), v.StartDate)
+	assert.True(t, v.ReleaseDueDate.IsZero())
+}
+
+func TestPullRequest_UnmarshalJSON_commits(t *testing.T) {
+	b, err := ioutil.ReadFile("testdata/json/pull_request_list.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	prs := []*backlog.PullRequest{}
+	if err := json.Unmarshal(b, &prs); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Nil(t, prs[0].BaseCommit)
+	assert.Nil(t, prs[0].BranchCommit)
+
+	assert.Equal(t, &backlog.Commit{ID: "a3f8b1c2d4e5f60718293a4b5c6d7e8f90a1b2c3"}, prs[1].BaseCommit)
+	assert.Equal(t, &backlog.Commit{
+		ID:          "0f1e2d3c4b5a69788796a5b4c3d2e1f0a9b8c7d6",
+		Message:     "Fix typo",
+		AuthorName:  "admin",
+		AuthorEmail: "admin@nulab.example",
+		AuthoredAt:  time.Date(2015, time.April, 24, 4, 30, 0, 0, time.UTC),
+	}, prs[1].BranchCommit)
+}
+
+func TestCommit_UnmarshalJSON_invalied(t *testing.T) {
+	c := backlog.Commit{}
+	assert.Error(t, json.Unmarshal([]byte(`1`), &c))
+	assert.Error(t, json.Unmarshal([]byte(`"abc`), &c))
+}
+
+func TestPullRequest_IsMerged_IsClosed(t *testing.T) {
+	at := time.Date(2015, time.April, 24, 5, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		pr     *backlog.PullRequest
+		merged bool
+		closed bool
+	}{
+		"open": {
+			pr:     &backlog.PullRequest{Status: &backlog.Status{ID: backlog.PullRequestStatusOpen}},
+			merged: false,
+			closed: false,
+		},
+		"closed": {
+			pr:     &backlog.PullRequest{Status: &backlog.Status{ID: backlog.PullRequestStatusClosed}},
+			merged: false,
+			closed: true,
+		},
+		"merged": {
+			pr:     &backlog.PullRequest{Status: &backlog.Status{ID: backlog.PullRequestStatusMerged}},
+			merged: true,
+			closed: true,
+		},
+		"closeAt_only": {
+			pr:     &backlog.PullRequest{CloseAt: at},
+			merged: false,
+			closed: true,
+		},
+		"mergeAt_only": {
+			pr:     &backlog.PullRequest{MergeAt: at},
+			merged: true,
+			closed: true,
+		},
+		"empty": {
+			pr:     &backlog.PullRequest{},
+			merged: false,
+			closed: false,
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, tc.merged, tc.pr.IsMerged())
+			assert.Equal(t, tc.closed, tc.pr.IsClosed())
+		})
+	}
+}
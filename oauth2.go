@@ -0,0 +1,222 @@
+package backlog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+// expiryDelta is how early a token is treated as expired.
+const expiryDelta = 10 * time.Second
+
+// Token represents OAuth 2.0 token issued by Backlog.
+type Token struct {
+	AccessToken  string    `json:"access_token"`
+	TokenType    string    `json:"token_type,omitempty"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	ExpiresIn    int       `json:"expires_in,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+}
+
+// Valid reports whether the token has access token and is not expired.
+func (t *Token) Valid() bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	if t.Expiry.IsZero() {
+		return true
+	}
+	return time.Now().Add(expiryDelta).Before(t.Expiry)
+}
+
+// TokenSource supplies OAuth 2.0 token.
+type TokenSource interface {
+	Token() (*Token, error)
+}
+
+// StaticTokenSource returns TokenSource which always returns the same token.
+// The token is never refreshed.
+func StaticTokenSource(t *Token) TokenSource {
+	return staticTokenSource{t: t}
+}
+
+type staticTokenSource struct {
+	t *Token
+}
+
+func (s staticTokenSource) Token() (*Token, error) {
+	if s.t == nil || s.t.AccessToken == "" {
+		return nil, newClientError("missing access token")
+	}
+	return s.t, nil
+}
+
+// OAuth2Config represents an OAuth 2.0 application registered in Backlog.
+type OAuth2Config struct {
+	// BaseURL is URL of your space. e.g. https://example.backlog.com
+	BaseURL      string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	// HTTPClient is used to request the token endpoint.
+	// http.DefaultClient is used if it is nil.
+	HTTPClient *http.Client
+}
+
+// AuthCodeURL returns URL of the page which asks the user to authorize the application.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/auth#oauth-2-0
+func (c *OAuth2Config) AuthCodeURL(state string) (string, error) {
+	u, err := url.ParseRequestURI(c.BaseURL)
+	if err != nil {
+		return "", err
+	}
+	if c.ClientID == "" {
+		return "", errors.New("ClientID must not be empty")
+	}
+
+	v := url.Values{}
+	v.Set("response_type", "code")
+	v.Set("client_id", c.ClientID)
+	if c.RedirectURL != "" {
+		v.Set("redirect_uri", c.RedirectURL)
+	}
+	if state != "" {
+		v.Set("state", state)
+	}
+
+	u.Path = path.Join(u.Path, "OAuth2AccessRequest.action")
+	u.RawQuery = v.Encode()
+
+	return u.String(), nil
+}
+
+// Exchange converts the authorization code into a token.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/auth#oauth-2-0
+func (c *OAuth2Config) Exchange(ctx context.Context, code string) (*Token, error) {
+	if code == "" {
+		return nil, errors.New("code must not be empty")
+	}
+
+	v := url.Values{}
+	v.Set("grant_type", "authorization_code")
+	v.Set("code", code)
+	if c.RedirectURL != "" {
+		v.Set("redirect_uri", c.RedirectURL)
+	}
+
+	return c.retrieveToken(ctx, v)
+}
+
+// Refresh gets a new token by the refresh token.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/auth#oauth-2-0
+func (c *OAuth2Config) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	if refreshToken == "" {
+		return nil, errors.New("refreshToken must not be empty")
+	}
+
+	v := url.Values{}
+	v.Set("grant_type", "refresh_token")
+	v.Set("refresh_token", refreshToken)
+
+	return c.retrieveToken(ctx, v)
+}
+
+// TokenSource returns TokenSource which returns the token until it expires
+// and refreshes it by the refresh token after that.
+func (c *OAuth2Config) TokenSource(ctx context.Context, t *Token) TokenSource {
+	return &refreshTokenSource{
+		ctx:    ctx,
+		config: c,
+		t:      t,
+	}
+}
+
+func (c *OAuth2Config) retrieveToken(ctx context.Context, v url.Values) (*Token, error) {
+	if ctx == nil {
+		return nil, errors.New("ctx must not be nil")
+	}
+	u, err := url.ParseRequestURI(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = path.Join(u.Path, "api", apiVersion, "oauth2", "token")
+
+	v.Set("client_id", c.ClientID)
+	v.Set("client_secret", c.ClientSecret)
+
+	req, err := http.NewRequest(http.MethodPost, u.String(), strings.NewReader(v.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := checkResponseError(newResponse(resp)); err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	t := Token{}
+	if err := json.NewDecoder(resp.Body).Decode(&t); err != nil {
+		return nil, err
+	}
+	if t.AccessToken == "" {
+		return nil, newClientError("token endpoint returned no access token")
+	}
+	if t.ExpiresIn > 0 {
+		t.Expiry = time.Now().Add(time.Duration(t.ExpiresIn) * time.Second)
+	}
+
+	return &t, nil
+}
+
+// refreshTokenSource is TokenSource which refreshes the expired token.
+type refreshTokenSource struct {
+	ctx    context.Context
+	config *OAuth2Config
+
+	mu sync.Mutex
+	t  *Token
+}
+
+func (s *refreshTokenSource) Token() (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.t.Valid() {
+		return s.t, nil
+	}
+	if s.t == nil || s.t.RefreshToken == "" {
+		return nil, newClientError("token is expired and has no refresh token")
+	}
+
+	t, err := s.config.Refresh(s.ctx, s.t.RefreshToken)
+	if err != nil {
+		return nil, fmt.Errorf("refresh token: %w", err)
+	}
+	if t.RefreshToken == "" {
+		t.RefreshToken = s.t.RefreshToken
+	}
+	s.t = t
+
+	return t, nil
+}
@@ -0,0 +1,246 @@
+package backlog_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToken_Valid(t *testing.T) {
+	cases := map[string]struct {
+		token *backlog.Token
+		want  bool
+	}{
+		"nil": {
+			token: nil,
+			want:  false,
+		},
+		"empty": {
+			token: &backlog.Token{},
+			want:  false,
+		},
+		"no-expiry": {
+			token: &backlog.Token{AccessToken: "access"},
+			want:  true,
+		},
+		"not-expired": {
+			token: &backlog.Token{AccessToken: "access", Expiry: time.Now().Add(time.Hour)},
+			want:  true,
+		},
+		"expired": {
+			token: &backlog.Token{AccessToken: "access", Expiry: time.Now().Add(-time.Hour)},
+			want:  false,
+		},
+		"expiring": {
+			token: &backlog.Token{AccessToken: "access", Expiry: time.Now().Add(time.Second)},
+			want:  false,
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.token.Valid())
+		})
+	}
+}
+
+func TestNewClientWithOAuth2(t *testing.T) {
+	c, err := backlog.NewClientWithOAuth2("https://test.backlog.com", nil)
+	assert.Error(t, err)
+	assert.Nil(t, c)
+
+	c, err = backlog.NewClientWithOAuth2("", backlog.StaticTokenSource(&backlog.Token{AccessToken: "access"}))
+	assert.Error(t, err)
+	assert.Nil(t, c)
+}
+
+func TestNewClientWithOAuth2_bearer(t *testing.T) {
+	ts := backlog.StaticTokenSource(&backlog.Token{AccessToken: "access"})
+	c, err := backlog.NewClientWithOAuth2("https://test.backlog.com", ts)
+	assert.NoError(t, err)
+
+	c.ExportSetHTTPClient(NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "Bearer access", req.Header.Get("Authorization"))
+		assert.Equal(t, "https://test.backlog.com/api/v2/spath", req.URL.String())
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}))
+
+	_, err = backlog.ExportClientGet(c, context.Background(), "spath", nil)
+	assert.NoError(t, err)
+}
+
+func TestNewClientWithOAuth2_tokenSourceError(t *testing.T) {
+	c, _ := backlog.NewClientWithOAuth2("https://test.backlog.com", backlog.StaticTokenSource(nil))
+	c.ExportSetHTTPClient(NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+		t.Error("httpClient.Do must never be called")
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}))
+
+	_, err := backlog.ExportClientGet(c, context.Background(), "spath", nil)
+	assert.Error(t, err)
+}
+
+func TestOAuth2Config_AuthCodeURL(t *testing.T) {
+	config := &backlog.OAuth2Config{
+		BaseURL:     "https://test.backlog.com",
+		ClientID:    "client",
+		RedirectURL: "https://example.com/callback",
+	}
+
+	u, err := config.AuthCodeURL("state")
+	assert.NoError(t, err)
+
+	parsed, _ := url.Parse(u)
+	assert.Equal(t, "test.backlog.com", parsed.Host)
+	assert.Equal(t, "/OAuth2AccessRequest.action", parsed.Path)
+	assert.Equal(t, "code", parsed.Query().Get("response_type"))
+	assert.Equal(t, "client", parsed.Query().Get("client_id"))
+	assert.Equal(t, "https://example.com/callback", parsed.Query().Get("redirect_uri"))
+	assert.Equal(t, "state", parsed.Query().Get("state"))
+}
+
+func TestOAuth2Config_AuthCodeURL_error(t *testing.T) {
+	_, err := (&backlog.OAuth2Config{BaseURL: "", ClientID: "client"}).AuthCodeURL("state")
+	assert.Error(t, err)
+
+	_, err = (&backlog.OAuth2Config{BaseURL: "https://test.backlog.com"}).AuthCodeURL("state")
+	assert.Error(t, err)
+}
+
+func newTokenResponse(body string) *http.Response {
+	header := http.Header{}
+	header.Set("Content-Type", "application/json;charset=utf-8")
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     header,
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+	}
+}
+
+func TestOAuth2Config_Exchange(t *testing.T) {
+	config := &backlog.OAuth2Config{
+		BaseURL:      "https://test.backlog.com",
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURL:  "https://example.com/callback",
+		HTTPClient: NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, "https://test.backlog.com/api/v2/oauth2/token", req.URL.String())
+			assert.Equal(t, "application/x-www-form-urlencoded", req.Header.Get("Content-Type"))
+			req.ParseForm()
+			assert.Equal(t, "authorization_code", req.PostForm.Get("grant_type"))
+			assert.Equal(t, "code", req.PostForm.Get("code"))
+			assert.Equal(t, "https://example.com/callback", req.PostForm.Get("redirect_uri"))
+			assert.Equal(t, "client", req.PostForm.Get("client_id"))
+			assert.Equal(t, "secret", req.PostForm.Get("client_secret"))
+			return newTokenResponse(`{"access_token":"access","token_type":"Bearer","expires_in":3600,"refresh_token":"refresh"}`), nil
+		}),
+	}
+
+	token, err := config.Exchange(context.Background(), "code")
+	assert.NoError(t, err)
+	assert.Equal(t, "access", token.AccessToken)
+	assert.Equal(t, "Bearer", token.TokenType)
+	assert.Equal(t, "refresh", token.RefreshToken)
+	assert.True(t, token.Valid())
+	assert.WithinDuration(t, time.Now().Add(time.Hour), token.Expiry, time.Minute)
+}
+
+func TestOAuth2Config_Exchange_error(t *testing.T) {
+	config := &backlog.OAuth2Config{
+		BaseURL: "https://test.backlog.com",
+		HTTPClient: NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusBadRequest,
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"errors":[{"message":"invalid code","code":11}]}`))),
+			}, nil
+		}),
+	}
+
+	token, err := config.Exchange(context.Background(), "")
+	assert.Error(t, err)
+	assert.Nil(t, token)
+
+	token, err = config.Exchange(context.Background(), "code")
+	assert.Error(t, err)
+	assert.Nil(t, token)
+}
+
+func TestOAuth2Config_TokenSource(t *testing.T) {
+	calls := 0
+	config := &backlog.OAuth2Config{
+		BaseURL:      "https://test.backlog.com",
+		ClientID:     "client",
+		ClientSecret: "secret",
+		HTTPClient: NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+			calls++
+			req.ParseForm()
+			assert.Equal(t, "refresh_token", req.PostForm.Get("grant_type"))
+			assert.Equal(t, "refresh", req.PostForm.Get("refresh_token"))
+			return newTokenResponse(`{"access_token":"new","token_type":"Bearer","expires_in":3600}`), nil
+		}),
+	}
+
+	valid := &backlog.Token{AccessToken: "access", RefreshToken: "refresh", Expiry: time.Now().Add(time.Hour)}
+	token, err := config.TokenSource(context.Background(), valid).Token()
+	assert.NoError(t, err)
+	assert.Equal(t, "access", token.AccessToken)
+	assert.Equal(t, 0, calls)
+
+	expired := &backlog.Token{AccessToken: "access", RefreshToken: "refresh", Expiry: time.Now().Add(-time.Hour)}
+	ts := config.TokenSource(context.Background(), expired)
+	token, err = ts.Token()
+	assert.NoError(t, err)
+	assert.Equal(t, "new", token.AccessToken)
+	assert.Equal(t, "refresh", token.RefreshToken)
+	assert.Equal(t, 1, calls)
+
+	// The refreshed token is reused.
+	token, err = ts.Token()
+	assert.NoError(t, err)
+	assert.Equal(t, "new", token.AccessToken)
+	assert.Equal(t, 1, calls)
+}
+
+func TestOAuth2Config_TokenSource_error(t *testing.T) {
+	config := &backlog.OAuth2Config{
+		BaseURL: "https://test.backlog.com",
+		HTTPClient: NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+			return nil, errors.New("error")
+		}),
+	}
+
+	expired := &backlog.Token{AccessToken: "access", Expiry: time.Now().Add(-time.Hour)}
+	_, err := config.TokenSource(context.Background(), expired).Token()
+	assert.Error(t, err)
+
+	expired.RefreshToken = "refresh"
+	_, err = config.TokenSource(context.Background(), expired).Token()
+	assert.Error(t, err)
+}
+
+func TestOAuth2Config_TokenSource_unauthorized(t *testing.T) {
+	config := &backlog.OAuth2Config{
+		BaseURL: "https://test.backlog.com",
+		HTTPClient: NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+			resp := newTokenResponse(`{"errors":[{"message":"Authentication failure.","code":11,"moreInfo":""}]}`)
+			resp.StatusCode = http.StatusUnauthorized
+			return resp, nil
+		}),
+	}
+
+	expired := &backlog.Token{AccessToken: "access", RefreshToken: "refresh", Expiry: time.Now().Add(-time.Hour)}
+	_, err := config.TokenSource(context.Background(), expired).Token()
+	assert.Error(t, err)
+	assert.True(t, backlog.IsUnauthorized(err))
+	assert.NotNil(t, backlog.AsAPIResponseError(err))
+}
@@ -4,10 +4,146 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 )
 
+// dateFormat is the layout of date-only parameters.
+const dateFormat = "2006-01-02"
+
 type option func(p *requestParams) error
 
+// withIDList sets IDs to the array parameter named key.
+func withIDList(key string, ids []int) option {
+	return func(p *requestParams) error {
+		for _, id := range ids {
+			if id < 1 {
+				return fmt.Errorf("%s must be 1 or more: %d", key, id)
+			}
+		}
+		for _, id := range ids {
+			p.Add(key+"[]", strconv.Itoa(id))
+		}
+		return nil
+	}
+}
+
+// withID sets ID to the parameter named key.
+func withID(key string, id int) option {
+	return func(p *requestParams) error {
+		if id < 1 {
+			return fmt.Errorf("%s must be 1 or more: %d", key, id)
+		}
+		p.Set(key, strconv.Itoa(id))
+		return nil
+	}
+}
+
+// withDate sets date to the parameter named key as yyyy-MM-dd.
+func withDate(key string, date Date) option {
+	return func(p *requestParams) error {
+		if date.IsZero() {
+			return fmt.Errorf("%s must not be zero", key)
+		}
+		p.Set(key, date.String())
+		return nil
+	}
+}
+
+// withHours sets hours to the parameter named key.
+func withHours(key string, hours float64) option {
+	return func(p *requestParams) error {
+		if hours < 0 {
+			return fmt.Errorf("%s must not be negative: %v", key, hours)
+		}
+		p.Set(key, strconv.FormatFloat(hours, 'f', -1, 64))
+		return nil
+	}
+}
+
+// withColor sets color which must be one of the colors.
+func withColor(color string, colors []string) option {
+	return func(p *requestParams) error {
+		for _, c := range colors {
+			if color == c {
+				p.Set("color", color)
+				return nil
+			}
+		}
+		return fmt.Errorf("color must be one of %s: %s", strings.Join(colors, ", "), color)
+	}
+}
+
+// clearParam sets empty value to the parameter named key to clear the field.
+func clearParam(p *requestParams, key string, clearable bool) error {
+	if !clearable {
+		return fmt.Errorf("%s can not be cleared", key)
+	}
+	p.Set(key, "")
+	return nil
+}
+
+// withOptionalString applies set if v is set, and clears the parameter named key if v is cleared.
+func withOptionalString(key string, v OptionalString, clearable bool, set func(string) option) option {
+	return func(p *requestParams) error {
+		switch v.state {
+		case optionalSet:
+			return set(v.value)(p)
+		case optionalClear:
+			return clearParam(p, key, clearable)
+		}
+		return nil
+	}
+}
+
+// withOptionalInt applies set if v is set, and clears the parameter named key if v is cleared.
+func withOptionalInt(key string, v OptionalInt, clearable bool, set func(int) option) option {
+	return func(p *requestParams) error {
+		switch v.state {
+		case optionalSet:
+			return set(v.value)(p)
+		case optionalClear:
+			return clearParam(p, key, clearable)
+		}
+		return nil
+	}
+}
+
+// withOptionalFloat applies set if v is set, and clears the parameter named key if v is cleared.
+func withOptionalFloat(key string, v OptionalFloat, clearable bool, set func(float64) option) option {
+	return func(p *requestParams) error {
+		switch v.state {
+		case optionalSet:
+			return set(v.value)(p)
+		case optionalClear:
+			return clearParam(p, key, clearable)
+		}
+		return nil
+	}
+}
+
+// withOptionalDate applies set if v is set, and clears the parameter named key if v is cleared.
+func withOptionalDate(key string, v OptionalDate, clearable bool, set func(Date) option) option {
+	return func(p *requestParams) error {
+		switch v.state {
+		case optionalSet:
+			return set(v.value)(p)
+		case optionalClear:
+			return clearParam(p, key, clearable)
+		}
+		return nil
+	}
+}
+
+// withOptionalBool applies set if v is set.
+func withOptionalBool(v OptionalBool, set func(bool) option) option {
+	return func(p *requestParams) error {
+		if v.state == optionalSet {
+			return set(v.value)(p)
+		}
+		return nil
+	}
+}
+
 func withActivityTypeIDs(typeIDs []int) option {
 	return func(p *requestParams) error {
 		for _, id := range typeIDs {
@@ -20,6 +156,28 @@ func withActivityTypeIDs(typeIDs []int) option {
 	}
 }
 
+func withActualHours(hours float64) option {
+	return withHours("actualHours", hours)
+}
+
+func withAllowAddItem(enabeld bool) option {
+	return func(p *requestParams) error {
+		p.Set("allowAddItem", strconv.FormatBool(enabeld))
+		return nil
+	}
+}
+
+func withAllowInput(enabeld bool) option {
+	return func(p *requestParams) error {
+		p.Set("allowInput", strconv.FormatBool(enabeld))
+		return nil
+	}
+}
+
+func withApplicableIssueTypes(ids []int) option {
+	return withIDList("applicableIssueTypes", ids)
+}
+
 func withArchived(archived bool) option {
 	return func(p *requestParams) error {
 		p.Set("archived", strconv.FormatBool(archived))
@@ -27,6 +185,29 @@ func withArchived(archived bool) option {
 	}
 }
 
+func withAssigneeID(id int) option {
+	return withID("assigneeId", id)
+}
+
+func withAssigneeIDs(ids []int) option {
+	return withIDList("assigneeId", ids)
+}
+
+func withAttachment(enabeld bool) option {
+	return func(p *requestParams) error {
+		p.Set("attachment", strconv.FormatBool(enabeld))
+		return nil
+	}
+}
+
+func withAttachmentIDs(ids []int) option {
+	return withIDList("attachmentId", ids)
+}
+
+func withCategoryIDs(ids []int) option {
+	return withIDList("categoryId", ids)
+}
+
 func withChartEnabled(enabeld bool) option {
 	return func(p *requestParams) error {
 		p.Set("chartEnabled", strconv.FormatBool(enabeld))
@@ -34,6 +215,16 @@ func withChartEnabled(enabeld bool) option {
 	}
 }
 
+func withComment(comment string) option {
+	return func(p *requestParams) error {
+		if comment == "" {
+			return errors.New("comment must not be empty")
+		}
+		p.Set("comment", comment)
+		return nil
+	}
+}
+
 func withContent(content string) option {
 	return func(p *requestParams) error {
 		if content == "" {
@@ -54,237 +245,1378 @@ func withCount(count int) option {
 	}
 }
 
-func withKey(key string) option {
+func withCreatedSince(since Date) option {
+	return withDate("createdSince", since)
+}
+
+func withCreatedUntil(until Date) option {
+	return withDate("createdUntil", until)
+}
+
+func withCreatedUserIDs(ids []int) option {
+	return withIDList("createdUserId", ids)
+}
+
+func withCustomFieldDate(customFieldID int, date Date) option {
 	return func(p *requestParams) error {
-		if key == "" {
-			return errors.New("key must not be empty")
+		if customFieldID < 1 {
+			return fmt.Errorf("customFieldID must be 1 or more: %d", customFieldID)
 		}
-		p.Set("key", key)
-		return nil
+		return withDate("customField_"+strconv.Itoa(customFieldID), date)(p)
 	}
 }
 
-func withName(name string) option {
+func withCustomFieldDateSince(customFieldID int, since Date) option {
 	return func(p *requestParams) error {
-		if name == "" {
-			return errors.New("name must not be empty")
+		if customFieldID < 1 {
+			return fmt.Errorf("customFieldID must be 1 or more: %d", customFieldID)
 		}
-		p.Set("name", name)
-		return nil
+		return withDate("customField_"+strconv.Itoa(customFieldID)+"_min", since)(p)
 	}
 }
 
-func withMailAddress(mailAddress string) option {
-	// ToDo: validate mailAddress
+func withCustomFieldDateUntil(customFieldID int, until Date) option {
 	return func(p *requestParams) error {
-		if mailAddress == "" {
-			return errors.New("mailAddress must not be empty")
+		if customFieldID < 1 {
+			return fmt.Errorf("customFieldID must be 1 or more: %d", customFieldID)
 		}
-		p.Set("mailAddress", mailAddress)
-		return nil
+		return withDate("customField_"+strconv.Itoa(customFieldID)+"_max", until)(p)
 	}
 }
 
-func withMailNotify(enabeld bool) option {
+func withCustomFieldItem(customFieldID int, itemID int) option {
 	return func(p *requestParams) error {
-		p.Set("mailNotify", strconv.FormatBool(enabeld))
-		return nil
+		if customFieldID < 1 {
+			return fmt.Errorf("customFieldID must be 1 or more: %d", customFieldID)
+		}
+		return withID("customField_"+strconv.Itoa(customFieldID), itemID)(p)
 	}
 }
 
-func withMaxID(maxID int) option {
+func withCustomFieldItemList(customFieldID int, itemIDs []int) option {
 	return func(p *requestParams) error {
-		if maxID < 1 {
-			return errors.New("maxId must be greater than 1")
+		if customFieldID < 1 {
+			return fmt.Errorf("customFieldID must be 1 or more: %d", customFieldID)
+		}
+		key := "customField_" + strconv.Itoa(customFieldID)
+		for _, id := range itemIDs {
+			if id < 1 {
+				return fmt.Errorf("%s must be 1 or more: %d", key, id)
+			}
+		}
+		p.Del(key)
+		for _, id := range itemIDs {
+			p.Add(key, strconv.Itoa(id))
 		}
-		p.Set("maxId", strconv.Itoa(maxID))
 		return nil
 	}
 }
 
-func withMinID(minID int) option {
+func withCustomFieldItems(customFieldID int, itemIDs []int) option {
 	return func(p *requestParams) error {
-		if minID < 1 {
-			return errors.New("minId must be greater than 1")
+		if customFieldID < 1 {
+			return fmt.Errorf("customFieldID must be 1 or more: %d", customFieldID)
 		}
-		p.Set("minId", strconv.Itoa(minID))
-		return nil
+		return withIDList("customField_"+strconv.Itoa(customFieldID), itemIDs)(p)
 	}
 }
 
-func withOrder(order order) option {
+func withCustomFieldKeyword(customFieldID int, keyword string) option {
 	return func(p *requestParams) error {
-		if order != OrderAsc && order != OrderDesc {
-			return fmt.Errorf("order must be only '%s' or '%s'", string(OrderAsc), string(OrderDesc))
+		if customFieldID < 1 {
+			return fmt.Errorf("customFieldID must be 1 or more: %d", customFieldID)
 		}
-		p.Set("order", string(order))
+		if keyword == "" {
+			return errors.New("keyword must not be empty")
+		}
+		p.Set("customField_"+strconv.Itoa(customFieldID), keyword)
 		return nil
 	}
 }
 
-func withPassword(password string) option {
+func withCustomFieldNumber(customFieldID int, number float64) option {
 	return func(p *requestParams) error {
-		if password == "" {
-			return errors.New("password must not be empty")
+		if customFieldID < 1 {
+			return fmt.Errorf("customFieldID must be 1 or more: %d", customFieldID)
 		}
-		p.Set("password", password)
+		p.Set("customField_"+strconv.Itoa(customFieldID), strconv.FormatFloat(number, 'f', -1, 64))
 		return nil
 	}
 }
 
-func withProjectLeaderCanEditProjectLeader(enabeld bool) option {
+func withCustomFieldNumberMax(customFieldID int, max float64) option {
 	return func(p *requestParams) error {
-		p.Set("projectLeaderCanEditProjectLeader", strconv.FormatBool(enabeld))
+		if customFieldID < 1 {
+			return fmt.Errorf("customFieldID must be 1 or more: %d", customFieldID)
+		}
+		p.Set("customField_"+strconv.Itoa(customFieldID)+"_max", strconv.FormatFloat(max, 'f', -1, 64))
 		return nil
 	}
 }
 
-func withRoleType(roleType role) option {
+func withCustomFieldNumberMin(customFieldID int, min float64) option {
 	return func(p *requestParams) error {
-		if roleType < 1 || 6 < roleType {
-			return errors.New("roleType must be between 1 and 7")
+		if customFieldID < 1 {
+			return fmt.Errorf("customFieldID must be 1 or more: %d", customFieldID)
 		}
-		p.Add("roleType", strconv.Itoa(int(roleType)))
+		p.Set("customField_"+strconv.Itoa(customFieldID)+"_min", strconv.FormatFloat(min, 'f', -1, 64))
 		return nil
 	}
 }
 
-func withSubtaskingEnabled(enabeld bool) option {
+func withCustomFieldOtherValue(customFieldID int, value string) option {
 	return func(p *requestParams) error {
-		p.Set("subtaskingEnabled", strconv.FormatBool(enabeld))
+		if customFieldID < 1 {
+			return fmt.Errorf("customFieldID must be 1 or more: %d", customFieldID)
+		}
+		p.Set("customField_"+strconv.Itoa(customFieldID)+"_otherValue", value)
 		return nil
 	}
 }
 
-func withTextFormattingRule(format format) option {
+func withCustomFieldText(customFieldID int, text string) option {
 	return func(p *requestParams) error {
-		if format != FormatBacklog && format != FormatMarkdown {
-			return fmt.Errorf("format must be only '%s' or '%s'", string(FormatBacklog), string(FormatMarkdown))
+		if customFieldID < 1 {
+			return fmt.Errorf("customFieldID must be 1 or more: %d", customFieldID)
 		}
-		p.Set("textFormattingRule", string(format))
+		p.Set("customField_"+strconv.Itoa(customFieldID), text)
 		return nil
 	}
 }
 
-// ActivityOption is type of functional option for ActivityService.
-type ActivityOption option
-
-// ActivityOptionService has methods to make functional option for ActivityService.
-type ActivityOptionService struct {
+func withDescription(description string) option {
+	return func(p *requestParams) error {
+		p.Set("description", description)
+		return nil
+	}
 }
 
-// WithActivityTypeIDs returns option. the option sets `activityTypeId` for user.
-func (*ActivityOptionService) WithActivityTypeIDs(typeIDs []int) ActivityOption {
-	return ActivityOption(withActivityTypeIDs(typeIDs))
+func withDueDate(date Date) option {
+	return withDate("dueDate", date)
 }
 
-// WithMinID returns option. the option sets `minId` for user.
-func (*ActivityOptionService) WithMinID(minID int) ActivityOption {
-	return ActivityOption(withMinID(minID))
+func withDueDateSince(since Date) option {
+	return withDate("dueDateSince", since)
 }
 
-// WithMaxID returns option. the option sets `maxId` for user.
-func (*ActivityOptionService) WithMaxID(maxID int) ActivityOption {
-	return ActivityOption(withMaxID(maxID))
+func withDueDateUntil(until Date) option {
+	return withDate("dueDateUntil", until)
 }
 
-// WithCount returns option. the option sets `count` for user.
-func (*ActivityOptionService) WithCount(count int) ActivityOption {
-	return ActivityOption(withCount(count))
+func withEstimatedHours(hours float64) option {
+	return withHours("estimatedHours", hours)
 }
 
-// WithOrder returns option. the option sets `order` for user.
-func (*ActivityOptionService) WithOrder(order order) ActivityOption {
-	return ActivityOption(withOrder(order))
+func withIDs(ids []int) option {
+	return withIDList("id", ids)
 }
 
-// ProjectOption is type of functional option for ProjectService.
-type ProjectOption option
+func withInitialDate(date Date) option {
+	return withDate("initialDate", date)
+}
 
-// ProjectOptionService has methods to make functional option for ProjectService.
-type ProjectOptionService struct {
+func withInitialShift(shift int) option {
+	return func(p *requestParams) error {
+		p.Set("initialShift", strconv.Itoa(shift))
+		return nil
+	}
 }
 
-// WithKey returns option. the option sets `key` for project.
-func (*ProjectOptionService) WithKey(key string) ProjectOption {
-	return ProjectOption(withKey(key))
+func withInitialValue(value float64) option {
+	return func(p *requestParams) error {
+		p.Set("initialValue", strconv.FormatFloat(value, 'f', -1, 64))
+		return nil
+	}
 }
 
-// WithName returns option. the option sets `name` for project.
-func (*ProjectOptionService) WithName(name string) ProjectOption {
-	return ProjectOption(withName(name))
+func withInitialValueType(valueType int) option {
+	return func(p *requestParams) error {
+		if valueType < 1 || 3 < valueType {
+			return fmt.Errorf("initialValueType must be between 1 and 3: %d", valueType)
+		}
+		p.Set("initialValueType", strconv.Itoa(valueType))
+		return nil
+	}
 }
 
-// WithChartEnabled returns option. the option sets `chartEnabled` for project.
-func (*ProjectOptionService) WithChartEnabled(enabeld bool) ProjectOption {
-	return ProjectOption(withChartEnabled(enabeld))
+func withIssueID(id int) option {
+	return withID("issueId", id)
 }
 
-// WithSubtaskingEnabled returns option. the option sets `subtaskingEnabled` for project.
-func (*ProjectOptionService) WithSubtaskingEnabled(enabeld bool) ProjectOption {
-	return ProjectOption(withSubtaskingEnabled(enabeld))
+func withIssueIDs(ids []int) option {
+	return withIDList("issueId", ids)
 }
 
-// WithProjectLeaderCanEditProjectLeader returns option. the option sets `projectLeaderCanEditProjectLeader` for project.
-func (*ProjectOptionService) WithProjectLeaderCanEditProjectLeader(enabeld bool) ProjectOption {
-	return ProjectOption(withProjectLeaderCanEditProjectLeader(enabeld))
+func withIssueTypeColor(color string) option {
+	return withColor(color, issueTypeColors)
 }
 
-// WithTextFormattingRule returns option. the option sets `textFormattingRule` for project.
-func (*ProjectOptionService) WithTextFormattingRule(format format) ProjectOption {
-	return ProjectOption(withTextFormattingRule(format))
+func withIssueTypeID(id int) option {
+	return withID("issueTypeId", id)
 }
 
-// WithArchived returns option. the option sets `archived` for project.
-func (*ProjectOptionService) WithArchived(archived bool) ProjectOption {
-	return ProjectOption(withArchived(archived))
+func withIssueTypeIDs(ids []int) option {
+	return withIDList("issueTypeId", ids)
 }
 
-// UserOption is type of functional option for UserService.
-type UserOption option
+func withItems(items []string) option {
+	return func(p *requestParams) error {
+		for _, item := range items {
+			if item == "" {
+				return errors.New("item must not be empty")
+			}
+		}
+		p.Del("items[]")
+		for _, item := range items {
+			p.Add("items[]", item)
+		}
+		return nil
+	}
+}
 
-// UserOptionService has methods to make functional option for UserService.
-type UserOptionService struct {
+func withKey(key string) option {
+	return func(p *requestParams) error {
+		if key == "" {
+			return errors.New("key must not be empty")
+		}
+		p.Set("key", key)
+		return nil
+	}
 }
 
-// WithPassword returns option. the option sets `password` for user.
-func (*UserOptionService) WithPassword(password string) UserOption {
-	return UserOption(withPassword(password))
+func withKeyword(keyword string) option {
+	return func(p *requestParams) error {
+		if keyword == "" {
+			return errors.New("keyword must not be empty")
+		}
+		p.Set("keyword", keyword)
+		return nil
+	}
 }
 
-// WithName returns option. the option sets `password` for user.
-func (*UserOptionService) WithName(name string) UserOption {
-	return UserOption(withName(name))
+func withMax(max float64) option {
+	return func(p *requestParams) error {
+		p.Set("max", strconv.FormatFloat(max, 'f', -1, 64))
+		return nil
+	}
 }
 
-// WithMailAddress returns option. the option sets `mailAddress` for user.
-func (*UserOptionService) WithMailAddress(mailAddress string) UserOption {
-	return UserOption(withMailAddress(mailAddress))
+func withMaxDate(date Date) option {
+	return withDate("max", date)
 }
 
-// WithRoleType returns option. the option sets `roleType` for user.
-func (*UserOptionService) WithRoleType(roleType role) UserOption {
+func withMin(min float64) option {
+	return func(p *requestParams) error {
+		p.Set("min", strconv.FormatFloat(min, 'f', -1, 64))
+		return nil
+	}
+}
+
+func withMinDate(date Date) option {
+	return withDate("min", date)
+}
+
+func withName(name string) option {
+	return func(p *requestParams) error {
+		if name == "" {
+			return errors.New("name must not be empty")
+		}
+		p.Set("name", name)
+		return nil
+	}
+}
+
+func withMailAddress(mailAddress string) option {
+	// ToDo: validate mailAddress
+	return func(p *requestParams) error {
+		if mailAddress == "" {
+			return errors.New("mailAddress must not be empty")
+		}
+		p.Set("mailAddress", mailAddress)
+		return nil
+	}
+}
+
+func withMailNotify(enabeld bool) option {
+	return func(p *requestParams) error {
+		p.Set("mailNotify", strconv.FormatBool(enabeld))
+		return nil
+	}
+}
+
+func withMaxID(maxID int) option {
+	return func(p *requestParams) error {
+		if maxID < 1 {
+			return errors.New("maxId must be greater than 1")
+		}
+		p.Set("maxId", strconv.Itoa(maxID))
+		return nil
+	}
+}
+
+func withMilestoneIDs(ids []int) option {
+	return withIDList("milestoneId", ids)
+}
+
+func withMinID(minID int) option {
+	return func(p *requestParams) error {
+		if minID < 1 {
+			return errors.New("minId must be greater than 1")
+		}
+		p.Set("minId", strconv.Itoa(minID))
+		return nil
+	}
+}
+
+func withNotifiedUserIDs(ids []int) option {
+	return withIDList("notifiedUserId", ids)
+}
+
+func withOffset(offset int) option {
+	return func(p *requestParams) error {
+		if offset < 0 {
+			return fmt.Errorf("offset must not be negative: %d", offset)
+		}
+		p.Set("offset", strconv.Itoa(offset))
+		return nil
+	}
+}
+
+func withOrder(order order) option {
+	return func(p *requestParams) error {
+		if order != OrderAsc && order != OrderDesc {
+			return fmt.Errorf("order must be only '%s' or '%s'", string(OrderAsc), string(OrderDesc))
+		}
+		p.Set("order", string(order))
+		return nil
+	}
+}
+
+func withParentChild(parentChild parentChild) option {
+	return func(p *requestParams) error {
+		if parentChild < ParentChildAll || ParentChildParent < parentChild {
+			return errors.New("parentChild must be between 0 and 4")
+		}
+		p.Set("parentChild", strconv.Itoa(int(parentChild)))
+		return nil
+	}
+}
+
+func withParentIssueID(id int) option {
+	return withID("parentIssueId", id)
+}
+
+func withParentIssueIDs(ids []int) option {
+	return withIDList("parentIssueId", ids)
+}
+
+func withPassword(password string) option {
+	return func(p *requestParams) error {
+		if password == "" {
+			return errors.New("password must not be empty")
+		}
+		p.Set("password", password)
+		return nil
+	}
+}
+
+func withPriorityID(id int) option {
+	return withID("priorityId", id)
+}
+
+func withPriorityIDs(ids []int) option {
+	return withIDList("priorityId", ids)
+}
+
+func withProjectIDs(ids []int) option {
+	return withIDList("projectId", ids)
+}
+
+func withProjectLeaderCanEditProjectLeader(enabeld bool) option {
+	return func(p *requestParams) error {
+		p.Set("projectLeaderCanEditProjectLeader", strconv.FormatBool(enabeld))
+		return nil
+	}
+}
+
+func withReleaseDueDate(date Date) option {
+	return withDate("releaseDueDate", date)
+}
+
+func withRequired(required bool) option {
+	return func(p *requestParams) error {
+		p.Set("required", strconv.FormatBool(required))
+		return nil
+	}
+}
+
+func withResolutionID(id int) option {
+	return withID("resolutionId", id)
+}
+
+func withResolutionIDs(ids []int) option {
+	return withIDList("resolutionId", ids)
+}
+
+func withRoleType(roleType role) option {
+	return func(p *requestParams) error {
+		if roleType < 1 || 6 < roleType {
+			return errors.New("roleType must be between 1 and 7")
+		}
+		p.Add("roleType", strconv.Itoa(int(roleType)))
+		return nil
+	}
+}
+
+func withSharedFile(enabeld bool) option {
+	return func(p *requestParams) error {
+		p.Set("sharedFile", strconv.FormatBool(enabeld))
+		return nil
+	}
+}
+
+func withSort(sort issueSort) option {
+	return func(p *requestParams) error {
+		if !sort.valid() {
+			return fmt.Errorf("sort is invalid: '%s'", string(sort))
+		}
+		p.Set("sort", string(sort))
+		return nil
+	}
+}
+
+func withStartDate(date Date) option {
+	return withDate("startDate", date)
+}
+
+func withStartDateSince(since Date) option {
+	return withDate("startDateSince", since)
+}
+
+func withStartDateUntil(until Date) option {
+	return withDate("startDateUntil", until)
+}
+
+func withStatusColor(color string) option {
+	return withColor(color, statusColors)
+}
+
+func withStatusID(id int) option {
+	return withID("statusId", id)
+}
+
+func withStatusIDs(ids []int) option {
+	return withIDList("statusId", ids)
+}
+
+func withSubtaskingEnabled(enabeld bool) option {
+	return func(p *requestParams) error {
+		p.Set("subtaskingEnabled", strconv.FormatBool(enabeld))
+		return nil
+	}
+}
+
+func withSummary(summary string) option {
+	return func(p *requestParams) error {
+		if summary == "" {
+			return errors.New("summary must not be empty")
+		}
+		p.Set("summary", summary)
+		return nil
+	}
+}
+
+func withTextFormattingRule(format format) option {
+	return func(p *requestParams) error {
+		if format != FormatBacklog && format != FormatMarkdown {
+			return fmt.Errorf("format must be only '%s' or '%s'", string(FormatBacklog), string(FormatMarkdown))
+		}
+		p.Set("textFormattingRule", string(format))
+		return nil
+	}
+}
+
+func withUnit(unit string) option {
+	return func(p *requestParams) error {
+		p.Set("unit", unit)
+		return nil
+	}
+}
+
+func withUpdatedSince(since Date) option {
+	return withDate("updatedSince", since)
+}
+
+func withUpdatedUntil(until Date) option {
+	return withDate("updatedUntil", until)
+}
+
+func withVersionIDs(ids []int) option {
+	return withIDList("versionId", ids)
+}
+
+// ActivityOption is type of functional option for ActivityService.
+type ActivityOption option
+
+// ActivityOptionService has methods to make functional option for ActivityService.
+type ActivityOptionService struct {
+}
+
+// WithActivityTypeIDs returns option. the option sets `activityTypeId` for user.
+func (*ActivityOptionService) WithActivityTypeIDs(typeIDs []int) ActivityOption {
+	return ActivityOption(withActivityTypeIDs(typeIDs))
+}
+
+// WithMinID returns option. the option sets `minId` for user.
+func (*ActivityOptionService) WithMinID(minID int) ActivityOption {
+	return ActivityOption(withMinID(minID))
+}
+
+// WithMaxID returns option. the option sets `maxId` for user.
+func (*ActivityOptionService) WithMaxID(maxID int) ActivityOption {
+	return ActivityOption(withMaxID(maxID))
+}
+
+// WithCount returns option. the option sets `count` for user.
+func (*ActivityOptionService) WithCount(count int) ActivityOption {
+	return ActivityOption(withCount(count))
+}
+
+// WithOrder returns option. the option sets `order` for user.
+func (*ActivityOptionService) WithOrder(order order) ActivityOption {
+	return ActivityOption(withOrder(order))
+}
+
+// ProjectOption is type of functional option for ProjectService.
+type ProjectOption option
+
+// ProjectOptionService has methods to make functional option for ProjectService.
+type ProjectOptionService struct {
+}
+
+// WithKey returns option. the option sets `key` for project.
+func (*ProjectOptionService) WithKey(key string) ProjectOption {
+	return ProjectOption(withKey(key))
+}
+
+// WithName returns option. the option sets `name` for project.
+func (*ProjectOptionService) WithName(name string) ProjectOption {
+	return ProjectOption(withName(name))
+}
+
+// WithChartEnabled returns option. the option sets `chartEnabled` for project.
+func (*ProjectOptionService) WithChartEnabled(enabeld bool) ProjectOption {
+	return ProjectOption(withChartEnabled(enabeld))
+}
+
+// WithSubtaskingEnabled returns option. the option sets `subtaskingEnabled` for project.
+func (*ProjectOptionService) WithSubtaskingEnabled(enabeld bool) ProjectOption {
+	return ProjectOption(withSubtaskingEnabled(enabeld))
+}
+
+// WithProjectLeaderCanEditProjectLeader returns option. the option sets `projectLeaderCanEditProjectLeader` for project.
+func (*ProjectOptionService) WithProjectLeaderCanEditProjectLeader(enabeld bool) ProjectOption {
+	return ProjectOption(withProjectLeaderCanEditProjectLeader(enabeld))
+}
+
+// WithTextFormattingRule returns option. the option sets `textFormattingRule` for project.
+func (*ProjectOptionService) WithTextFormattingRule(format format) ProjectOption {
+	return ProjectOption(withTextFormattingRule(format))
+}
+
+// WithArchived returns option. the option sets `archived` for project.
+func (*ProjectOptionService) WithArchived(archived bool) ProjectOption {
+	return ProjectOption(withArchived(archived))
+}
+
+// WithOptionalName returns option. the option sets `name` for project if v is set.
+// The name can not be cleared.
+func (*ProjectOptionService) WithOptionalName(v OptionalString) ProjectOption {
+	return ProjectOption(withOptionalString("name", v, false, withName))
+}
+
+// WithOptionalKey returns option. the option sets `key` for project if v is set.
+// The key can not be cleared.
+func (*ProjectOptionService) WithOptionalKey(v OptionalString) ProjectOption {
+	return ProjectOption(withOptionalString("key", v, false, withKey))
+}
+
+// WithOptionalChartEnabled returns option. the option sets `chartEnabled` for project if v is set.
+func (*ProjectOptionService) WithOptionalChartEnabled(v OptionalBool) ProjectOption {
+	return ProjectOption(withOptionalBool(v, withChartEnabled))
+}
+
+// WithOptionalSubtaskingEnabled returns option. the option sets `subtaskingEnabled` for project if v is set.
+func (*ProjectOptionService) WithOptionalSubtaskingEnabled(v OptionalBool) ProjectOption {
+	return ProjectOption(withOptionalBool(v, withSubtaskingEnabled))
+}
+
+// WithOptionalProjectLeaderCanEditProjectLeader returns option. the option sets `projectLeaderCanEditProjectLeader` for project if v is set.
+func (*ProjectOptionService) WithOptionalProjectLeaderCanEditProjectLeader(v OptionalBool) ProjectOption {
+	return ProjectOption(withOptionalBool(v, withProjectLeaderCanEditProjectLeader))
+}
+
+// WithOptionalArchived returns option. the option sets `archived` for project if v is set.
+func (*ProjectOptionService) WithOptionalArchived(v OptionalBool) ProjectOption {
+	return ProjectOption(withOptionalBool(v, withArchived))
+}
+
+// UserOption is type of functional option for UserService.
+type UserOption option
+
+// UserOptionService has methods to make functional option for UserService.
+type UserOptionService struct {
+}
+
+// WithPassword returns option. the option sets `password` for user.
+func (*UserOptionService) WithPassword(password string) UserOption {
+	return UserOption(withPassword(password))
+}
+
+// WithName returns option. the option sets `password` for user.
+func (*UserOptionService) WithName(name string) UserOption {
+	return UserOption(withName(name))
+}
+
+// WithMailAddress returns option. the option sets `mailAddress` for user.
+func (*UserOptionService) WithMailAddress(mailAddress string) UserOption {
+	return UserOption(withMailAddress(mailAddress))
+}
+
+// WithRoleType returns option. the option sets `roleType` for user.
+func (*UserOptionService) WithRoleType(roleType role) UserOption {
 	return UserOption(withRoleType(roleType))
 }
 
-// WikiOption is type of functional option for WikiService.
-type WikiOption option
+// WithOptionalPassword returns option. the option sets `password` for user if v is set.
+// The password can not be cleared.
+func (*UserOptionService) WithOptionalPassword(v OptionalString) UserOption {
+	return UserOption(withOptionalString("password", v, false, withPassword))
+}
+
+// WithOptionalName returns option. the option sets `name` for user if v is set.
+// The name can not be cleared.
+func (*UserOptionService) WithOptionalName(v OptionalString) UserOption {
+	return UserOption(withOptionalString("name", v, false, withName))
+}
+
+// WithOptionalMailAddress returns option. the option sets `mailAddress` for user if v is set.
+// The mail address can not be cleared.
+func (*UserOptionService) WithOptionalMailAddress(v OptionalString) UserOption {
+	return UserOption(withOptionalString("mailAddress", v, false, withMailAddress))
+}
+
+// WikiOption is type of functional option for WikiService.
+type WikiOption option
+
+// WikiOptionService has methods to make functional option for WikiService.
+type WikiOptionService struct {
+}
+
+// WithName returns option. the option sets `name` for wiki.
+func (*WikiOptionService) WithName(name string) WikiOption {
+	return WikiOption(withName(name))
+}
+
+// WithContent returns option. the option sets `content` for wiki.
+func (*WikiOptionService) WithContent(content string) WikiOption {
+	return WikiOption(withContent(content))
+}
+
+// WithMailNotify returns option. the option sets `mailNotify` for wiki.
+func (*WikiOptionService) WithMailNotify(enabeld bool) WikiOption {
+	return WikiOption(withMailNotify(enabeld))
+}
+
+// WithOptionalName returns option. the option sets `name` for wiki if v is set.
+// The name can not be cleared.
+func (*WikiOptionService) WithOptionalName(v OptionalString) WikiOption {
+	return WikiOption(withOptionalString("name", v, false, withName))
+}
+
+// WithOptionalContent returns option. the option sets `content` for wiki if v is set,
+// and empties the content if v is cleared.
+func (*WikiOptionService) WithOptionalContent(v OptionalString) WikiOption {
+	return WikiOption(withOptionalString("content", v, true, withContent))
+}
+
+// WithOptionalMailNotify returns option. the option sets `mailNotify` for wiki if v is set.
+func (*WikiOptionService) WithOptionalMailNotify(v OptionalBool) WikiOption {
+	return WikiOption(withOptionalBool(v, withMailNotify))
+}
+
+// WikiHistoryOption is type of functional option for WikiHistoryService.
+type WikiHistoryOption option
+
+// WikiHistoryOptionService has methods to make functional option for WikiHistoryService.
+type WikiHistoryOptionService struct {
+}
+
+// WithMinID returns option. the option sets `minId` for wiki history.
+func (*WikiHistoryOptionService) WithMinID(minID int) WikiHistoryOption {
+	return WikiHistoryOption(withMinID(minID))
+}
+
+// WithMaxID returns option. the option sets `maxId` for wiki history.
+func (*WikiHistoryOptionService) WithMaxID(maxID int) WikiHistoryOption {
+	return WikiHistoryOption(withMaxID(maxID))
+}
+
+// WithCount returns option. the option sets `count` for wiki history.
+func (*WikiHistoryOptionService) WithCount(count int) WikiHistoryOption {
+	return WikiHistoryOption(withCount(count))
+}
+
+// WithOrder returns option. the option sets `order` for wiki history.
+func (*WikiHistoryOptionService) WithOrder(order order) WikiHistoryOption {
+	return WikiHistoryOption(withOrder(order))
+}
+
+// CommentListOption is type of functional option for listing comments.
+type CommentListOption option
+
+// CommentOption is type of functional option for adding comments.
+type CommentOption option
+
+// CommentOptionService has methods to make functional option for comment services.
+type CommentOptionService struct {
+}
+
+// WithMinID returns option. the option sets `minId` for comment list.
+func (*CommentOptionService) WithMinID(minID int) CommentListOption {
+	return CommentListOption(withMinID(minID))
+}
 
-// WikiOptionService has methods to make functional option for WikiService.
-type WikiOptionService struct {
+// WithMaxID returns option. the option sets `maxId` for comment list.
+func (*CommentOptionService) WithMaxID(maxID int) CommentListOption {
+	return CommentListOption(withMaxID(maxID))
 }
 
-// WithName returns option. the option sets `name` for wiki.
-func (*WikiOptionService) WithName(name string) WikiOption {
-	return WikiOption(withName(name))
+// WithCount returns option. the option sets `count` for comment list.
+func (*CommentOptionService) WithCount(count int) CommentListOption {
+	return CommentListOption(withCount(count))
 }
 
-// WithContent returns option. the option sets `content` for wiki.
-func (*WikiOptionService) WithContent(content string) WikiOption {
-	return WikiOption(withContent(content))
+// WithOrder returns option. the option sets `order` for comment list.
+func (*CommentOptionService) WithOrder(order order) CommentListOption {
+	return CommentListOption(withOrder(order))
 }
 
-// WithMailNotify returns option. the option sets `mailNotify` for wiki.
-func (*WikiOptionService) WithMailNotify(enabeld bool) WikiOption {
-	return WikiOption(withMailNotify(enabeld))
+// WithNotifiedUserIDs returns option. the option sets `notifiedUserId[]` for comment.
+func (*CommentOptionService) WithNotifiedUserIDs(ids []int) CommentOption {
+	return CommentOption(withNotifiedUserIDs(ids))
+}
+
+// WithAttachmentIDs returns option. the option sets `attachmentId[]` for comment.
+func (*CommentOptionService) WithAttachmentIDs(ids []int) CommentOption {
+	return CommentOption(withAttachmentIDs(ids))
+}
+
+// IssueListOption is type of functional option for listing and counting issues.
+type IssueListOption option
+
+// IssueListOptionService has methods to make functional option for listing and counting issues.
+type IssueListOptionService struct {
+}
+
+// WithProjectIDs returns option. the option sets `projectId[]` for issue.
+func (*IssueListOptionService) WithProjectIDs(ids []int) IssueListOption {
+	return IssueListOption(withProjectIDs(ids))
+}
+
+// WithIssueTypeIDs returns option. the option sets `issueTypeId[]` for issue.
+func (*IssueListOptionService) WithIssueTypeIDs(ids []int) IssueListOption {
+	return IssueListOption(withIssueTypeIDs(ids))
+}
+
+// WithCategoryIDs returns option. the option sets `categoryId[]` for issue.
+func (*IssueListOptionService) WithCategoryIDs(ids []int) IssueListOption {
+	return IssueListOption(withCategoryIDs(ids))
+}
+
+// WithVersionIDs returns option. the option sets `versionId[]` for issue.
+func (*IssueListOptionService) WithVersionIDs(ids []int) IssueListOption {
+	return IssueListOption(withVersionIDs(ids))
+}
+
+// WithMilestoneIDs returns option. the option sets `milestoneId[]` for issue.
+func (*IssueListOptionService) WithMilestoneIDs(ids []int) IssueListOption {
+	return IssueListOption(withMilestoneIDs(ids))
+}
+
+// WithStatusIDs returns option. the option sets `statusId[]` for issue.
+func (*IssueListOptionService) WithStatusIDs(ids []int) IssueListOption {
+	return IssueListOption(withStatusIDs(ids))
+}
+
+// WithPriorityIDs returns option. the option sets `priorityId[]` for issue.
+func (*IssueListOptionService) WithPriorityIDs(ids []int) IssueListOption {
+	return IssueListOption(withPriorityIDs(ids))
+}
+
+// WithAssigneeIDs returns option. the option sets `assigneeId[]` for issue.
+func (*IssueListOptionService) WithAssigneeIDs(ids []int) IssueListOption {
+	return IssueListOption(withAssigneeIDs(ids))
+}
+
+// WithCreatedUserIDs returns option. the option sets `createdUserId[]` for issue.
+func (*IssueListOptionService) WithCreatedUserIDs(ids []int) IssueListOption {
+	return IssueListOption(withCreatedUserIDs(ids))
+}
+
+// WithResolutionIDs returns option. the option sets `resolutionId[]` for issue.
+func (*IssueListOptionService) WithResolutionIDs(ids []int) IssueListOption {
+	return IssueListOption(withResolutionIDs(ids))
+}
+
+// WithParentChild returns option. the option sets `parentChild` for issue.
+func (*IssueListOptionService) WithParentChild(parentChild parentChild) IssueListOption {
+	return IssueListOption(withParentChild(parentChild))
+}
+
+// WithAttachment returns option. the option sets `attachment` for issue.
+func (*IssueListOptionService) WithAttachment(enabeld bool) IssueListOption {
+	return IssueListOption(withAttachment(enabeld))
+}
+
+// WithSharedFile returns option. the option sets `sharedFile` for issue.
+func (*IssueListOptionService) WithSharedFile(enabeld bool) IssueListOption {
+	return IssueListOption(withSharedFile(enabeld))
+}
+
+// WithSort returns option. the option sets `sort` for issue.
+func (*IssueListOptionService) WithSort(sort issueSort) IssueListOption {
+	return IssueListOption(withSort(sort))
+}
+
+// WithOrder returns option. the option sets `order` for issue.
+func (*IssueListOptionService) WithOrder(order order) IssueListOption {
+	return IssueListOption(withOrder(order))
+}
+
+// WithOffset returns option. the option sets `offset` for issue.
+func (*IssueListOptionService) WithOffset(offset int) IssueListOption {
+	return IssueListOption(withOffset(offset))
+}
+
+// WithCount returns option. the option sets `count` for issue.
+func (*IssueListOptionService) WithCount(count int) IssueListOption {
+	return IssueListOption(withCount(count))
+}
+
+// WithCreatedSince returns option. the option sets `createdSince` for issue.
+func (*IssueListOptionService) WithCreatedSince(since Date) IssueListOption {
+	return IssueListOption(withCreatedSince(since))
+}
+
+// WithCreatedUntil returns option. the option sets `createdUntil` for issue.
+func (*IssueListOptionService) WithCreatedUntil(until Date) IssueListOption {
+	return IssueListOption(withCreatedUntil(until))
+}
+
+// WithUpdatedSince returns option. the option sets `updatedSince` for issue.
+func (*IssueListOptionService) WithUpdatedSince(since Date) IssueListOption {
+	return IssueListOption(withUpdatedSince(since))
+}
+
+// WithUpdatedUntil returns option. the option sets `updatedUntil` for issue.
+func (*IssueListOptionService) WithUpdatedUntil(until Date) IssueListOption {
+	return IssueListOption(withUpdatedUntil(until))
+}
+
+// WithStartDateSince returns option. the option sets `startDateSince` for issue.
+func (*IssueListOptionService) WithStartDateSince(since Date) IssueListOption {
+	return IssueListOption(withStartDateSince(since))
+}
+
+// WithStartDateUntil returns option. the option sets `startDateUntil` for issue.
+func (*IssueListOptionService) WithStartDateUntil(until Date) IssueListOption {
+	return IssueListOption(withStartDateUntil(until))
+}
+
+// WithDueDateSince returns option. the option sets `dueDateSince` for issue.
+func (*IssueListOptionService) WithDueDateSince(since Date) IssueListOption {
+	return IssueListOption(withDueDateSince(since))
+}
+
+// WithDueDateUntil returns option. the option sets `dueDateUntil` for issue.
+func (*IssueListOptionService) WithDueDateUntil(until Date) IssueListOption {
+	return IssueListOption(withDueDateUntil(until))
+}
+
+// WithIDs returns option. the option sets `id[]` for issue.
+func (*IssueListOptionService) WithIDs(ids []int) IssueListOption {
+	return IssueListOption(withIDs(ids))
+}
+
+// WithParentIssueIDs returns option. the option sets `parentIssueId[]` for issue.
+func (*IssueListOptionService) WithParentIssueIDs(ids []int) IssueListOption {
+	return IssueListOption(withParentIssueIDs(ids))
+}
+
+// WithKeyword returns option. the option sets `keyword` for issue.
+func (*IssueListOptionService) WithKeyword(keyword string) IssueListOption {
+	return IssueListOption(withKeyword(keyword))
+}
+
+// WithCustomFieldKeyword returns option. the option sets `customField_${id}` for issue.
+func (*IssueListOptionService) WithCustomFieldKeyword(customFieldID int, keyword string) IssueListOption {
+	return IssueListOption(withCustomFieldKeyword(customFieldID, keyword))
+}
+
+// WithCustomFieldNumberMin returns option. the option sets `customField_${id}_min` for issue.
+func (*IssueListOptionService) WithCustomFieldNumberMin(customFieldID int, min float64) IssueListOption {
+	return IssueListOption(withCustomFieldNumberMin(customFieldID, min))
+}
+
+// WithCustomFieldNumberMax returns option. the option sets `customField_${id}_max` for issue.
+func (*IssueListOptionService) WithCustomFieldNumberMax(customFieldID int, max float64) IssueListOption {
+	return IssueListOption(withCustomFieldNumberMax(customFieldID, max))
+}
+
+// WithCustomFieldDateSince returns option. the option sets `customField_${id}_min` for issue.
+func (*IssueListOptionService) WithCustomFieldDateSince(customFieldID int, since Date) IssueListOption {
+	return IssueListOption(withCustomFieldDateSince(customFieldID, since))
+}
+
+// WithCustomFieldDateUntil returns option. the option sets `customField_${id}_max` for issue.
+func (*IssueListOptionService) WithCustomFieldDateUntil(customFieldID int, until Date) IssueListOption {
+	return IssueListOption(withCustomFieldDateUntil(customFieldID, until))
+}
+
+// WithCustomFieldItems returns option. the option sets `customField_${id}[]` for issue.
+func (*IssueListOptionService) WithCustomFieldItems(customFieldID int, itemIDs []int) IssueListOption {
+	return IssueListOption(withCustomFieldItems(customFieldID, itemIDs))
+}
+
+// IssueOption is type of functional option for creating and updating issues.
+type IssueOption option
+
+// IssueOptionService has methods to make functional option for creating and updating issues.
+type IssueOptionService struct {
+}
+
+// WithCustomFieldTextValue returns option. the option sets text to `customField_${id}` for issue.
+// It is for custom fields of text and sentence type.
+func (*IssueOptionService) WithCustomFieldTextValue(customFieldID int, text string) IssueOption {
+	return IssueOption(withCustomFieldText(customFieldID, text))
+}
+
+// WithCustomFieldNumberValue returns option. the option sets number to `customField_${id}` for issue.
+func (*IssueOptionService) WithCustomFieldNumberValue(customFieldID int, number float64) IssueOption {
+	return IssueOption(withCustomFieldNumber(customFieldID, number))
+}
+
+// WithCustomFieldDateValue returns option. the option sets date to `customField_${id}` for issue.
+func (*IssueOptionService) WithCustomFieldDateValue(customFieldID int, date Date) IssueOption {
+	return IssueOption(withCustomFieldDate(customFieldID, date))
+}
+
+// WithCustomFieldItemValue returns option. the option sets item ID to `customField_${id}` for issue.
+// It is for custom fields of single list and radio type.
+func (*IssueOptionService) WithCustomFieldItemValue(customFieldID int, itemID int) IssueOption {
+	return IssueOption(withCustomFieldItem(customFieldID, itemID))
+}
+
+// WithCustomFieldItemsValue returns option. the option sets item IDs to `customField_${id}` for issue.
+// It is for custom fields of multiple list and checkbox type.
+func (*IssueOptionService) WithCustomFieldItemsValue(customFieldID int, itemIDs []int) IssueOption {
+	return IssueOption(withCustomFieldItemList(customFieldID, itemIDs))
+}
+
+// WithCustomFieldOtherValue returns option. the option sets `customField_${id}_otherValue` for issue.
+// It is for custom fields of list type which allow to add an item.
+func (*IssueOptionService) WithCustomFieldOtherValue(customFieldID int, value string) IssueOption {
+	return IssueOption(withCustomFieldOtherValue(customFieldID, value))
+}
+
+// WithSummary returns option. the option sets `summary` for issue.
+func (*IssueOptionService) WithSummary(summary string) IssueOption {
+	return IssueOption(withSummary(summary))
+}
+
+// WithParentIssueID returns option. the option sets `parentIssueId` for issue.
+func (*IssueOptionService) WithParentIssueID(id int) IssueOption {
+	return IssueOption(withParentIssueID(id))
+}
+
+// WithDescription returns option. the option sets `description` for issue.
+func (*IssueOptionService) WithDescription(description string) IssueOption {
+	return IssueOption(withDescription(description))
+}
+
+// WithStartDate returns option. the option sets `startDate` for issue.
+func (*IssueOptionService) WithStartDate(date Date) IssueOption {
+	return IssueOption(withStartDate(date))
+}
+
+// WithDueDate returns option. the option sets `dueDate` for issue.
+func (*IssueOptionService) WithDueDate(date Date) IssueOption {
+	return IssueOption(withDueDate(date))
+}
+
+// WithEstimatedHours returns option. the option sets `estimatedHours` for issue.
+func (*IssueOptionService) WithEstimatedHours(hours float64) IssueOption {
+	return IssueOption(withEstimatedHours(hours))
+}
+
+// WithActualHours returns option. the option sets `actualHours` for issue.
+func (*IssueOptionService) WithActualHours(hours float64) IssueOption {
+	return IssueOption(withActualHours(hours))
+}
+
+// WithIssueTypeID returns option. the option sets `issueTypeId` for issue.
+func (*IssueOptionService) WithIssueTypeID(id int) IssueOption {
+	return IssueOption(withIssueTypeID(id))
+}
+
+// WithStatusID returns option. the option sets `statusId` for issue.
+func (*IssueOptionService) WithStatusID(id int) IssueOption {
+	return IssueOption(withStatusID(id))
+}
+
+// WithResolutionID returns option. the option sets `resolutionId` for issue.
+func (*IssueOptionService) WithResolutionID(id int) IssueOption {
+	return IssueOption(withResolutionID(id))
+}
+
+// WithPriorityID returns option. the option sets `priorityId` for issue.
+func (*IssueOptionService) WithPriorityID(id int) IssueOption {
+	return IssueOption(withPriorityID(id))
+}
+
+// WithAssigneeID returns option. the option sets `assigneeId` for issue.
+func (*IssueOptionService) WithAssigneeID(id int) IssueOption {
+	return IssueOption(withAssigneeID(id))
+}
+
+// WithNotifiedUserIDs returns option. the option sets `notifiedUserId[]` for issue.
+func (*IssueOptionService) WithNotifiedUserIDs(ids []int) IssueOption {
+	return IssueOption(withNotifiedUserIDs(ids))
+}
+
+// WithAttachmentIDs returns option. the option sets `attachmentId[]` for issue.
+func (*IssueOptionService) WithAttachmentIDs(ids []int) IssueOption {
+	return IssueOption(withAttachmentIDs(ids))
+}
+
+// WithComment returns option. the option sets `comment` for issue.
+func (*IssueOptionService) WithComment(comment string) IssueOption {
+	return IssueOption(withComment(comment))
+}
+
+// WithOptionalDescription returns option. the option sets `description` for issue if v is set,
+// and empties the description if v is cleared.
+func (*IssueOptionService) WithOptionalDescription(v OptionalString) IssueOption {
+	return IssueOption(withOptionalString("description", v, true, withDescription))
+}
+
+// WithOptionalStartDate returns option. the option sets `startDate` for issue if v is set,
+// and removes the start date if v is cleared.
+func (*IssueOptionService) WithOptionalStartDate(v OptionalDate) IssueOption {
+	return IssueOption(withOptionalDate("startDate", v, true, withStartDate))
+}
+
+// WithOptionalDueDate returns option. the option sets `dueDate` for issue if v is set,
+// and removes the due date if v is cleared.
+func (*IssueOptionService) WithOptionalDueDate(v OptionalDate) IssueOption {
+	return IssueOption(withOptionalDate("dueDate", v, true, withDueDate))
+}
+
+// WithOptionalEstimatedHours returns option. the option sets `estimatedHours` for issue if v is set,
+// and removes the estimated hours if v is cleared.
+func (*IssueOptionService) WithOptionalEstimatedHours(v OptionalFloat) IssueOption {
+	return IssueOption(withOptionalFloat("estimatedHours", v, true, withEstimatedHours))
+}
+
+// WithOptionalActualHours returns option. the option sets `actualHours` for issue if v is set,
+// and removes the actual hours if v is cleared.
+func (*IssueOptionService) WithOptionalActualHours(v OptionalFloat) IssueOption {
+	return IssueOption(withOptionalFloat("actualHours", v, true, withActualHours))
+}
+
+// WithOptionalAssigneeID returns option. the option sets `assigneeId` for issue if v is set,
+// and unassigns the issue if v is cleared.
+func (*IssueOptionService) WithOptionalAssigneeID(v OptionalInt) IssueOption {
+	return IssueOption(withOptionalInt("assigneeId", v, true, withAssigneeID))
+}
+
+// WithOptionalParentIssueID returns option. the option sets `parentIssueId` for issue if v is set,
+// and removes the parent issue if v is cleared.
+func (*IssueOptionService) WithOptionalParentIssueID(v OptionalInt) IssueOption {
+	return IssueOption(withOptionalInt("parentIssueId", v, true, withParentIssueID))
+}
+
+// WithOptionalResolutionID returns option. the option sets `resolutionId` for issue if v is set,
+// and removes the resolution if v is cleared.
+func (*IssueOptionService) WithOptionalResolutionID(v OptionalInt) IssueOption {
+	return IssueOption(withOptionalInt("resolutionId", v, true, withResolutionID))
+}
+
+// StatusOption is type of functional option for StatusService.
+type StatusOption option
+
+// StatusOptionService has methods to make functional option for StatusService.
+type StatusOptionService struct {
+}
+
+// WithName returns option. the option sets `name` for status.
+func (*StatusOptionService) WithName(name string) StatusOption {
+	return StatusOption(withName(name))
+}
+
+// WithColor returns option. the option sets `color` for status.
+func (*StatusOptionService) WithColor(color string) StatusOption {
+	return StatusOption(withStatusColor(color))
+}
+
+// IssueTypeOption is type of functional option for IssueTypeService.
+type IssueTypeOption option
+
+// IssueTypeOptionService has methods to make functional option for IssueTypeService.
+type IssueTypeOptionService struct {
+}
+
+// WithName returns option. the option sets `name` for issue type.
+func (*IssueTypeOptionService) WithName(name string) IssueTypeOption {
+	return IssueTypeOption(withName(name))
+}
+
+// WithColor returns option. the option sets `color` for issue type.
+func (*IssueTypeOptionService) WithColor(color string) IssueTypeOption {
+	return IssueTypeOption(withIssueTypeColor(color))
+}
+
+// VersionOption is type of functional option for VersionService.
+type VersionOption option
+
+// VersionOptionService has methods to make functional option for VersionService.
+type VersionOptionService struct {
+}
+
+// WithDescription returns option. the option sets `description` for version.
+func (*VersionOptionService) WithDescription(description string) VersionOption {
+	return VersionOption(withDescription(description))
+}
+
+// WithStartDate returns option. the option sets `startDate` for version.
+func (*VersionOptionService) WithStartDate(date Date) VersionOption {
+	return VersionOption(withStartDate(date))
+}
+
+// WithReleaseDueDate returns option. the option sets `releaseDueDate` for version.
+func (*VersionOptionService) WithReleaseDueDate(date Date) VersionOption {
+	return VersionOption(withReleaseDueDate(date))
+}
+
+// WithArchived returns option. the option sets `archived` for version.
+func (*VersionOptionService) WithArchived(archived bool) VersionOption {
+	return VersionOption(withArchived(archived))
+}
+
+// CustomFieldOption is type of functional option for CustomFieldService.
+type CustomFieldOption option
+
+// CustomFieldOptionService has methods to make functional option for CustomFieldService.
+type CustomFieldOptionService struct {
+}
+
+// WithName returns option. the option sets `name` for custom field.
+func (*CustomFieldOptionService) WithName(name string) CustomFieldOption {
+	return CustomFieldOption(withName(name))
+}
+
+// WithApplicableIssueTypes returns option. the option sets `applicableIssueTypes[]` for custom field.
+func (*CustomFieldOptionService) WithApplicableIssueTypes(issueTypeIDs []int) CustomFieldOption {
+	return CustomFieldOption(withApplicableIssueTypes(issueTypeIDs))
+}
+
+// WithDescription returns option. the option sets `description` for custom field.
+func (*CustomFieldOptionService) WithDescription(description string) CustomFieldOption {
+	return CustomFieldOption(withDescription(description))
+}
+
+// WithRequired returns option. the option sets `required` for custom field.
+func (*CustomFieldOptionService) WithRequired(required bool) CustomFieldOption {
+	return CustomFieldOption(withRequired(required))
+}
+
+// WithMin returns option. the option sets `min` for numeric custom field.
+func (*CustomFieldOptionService) WithMin(min float64) CustomFieldOption {
+	return CustomFieldOption(withMin(min))
+}
+
+// WithMax returns option. the option sets `max` for numeric custom field.
+func (*CustomFieldOptionService) WithMax(max float64) CustomFieldOption {
+	return CustomFieldOption(withMax(max))
+}
+
+// WithInitialValue returns option. the option sets `initialValue` for numeric custom field.
+func (*CustomFieldOptionService) WithInitialValue(value float64) CustomFieldOption {
+	return CustomFieldOption(withInitialValue(value))
+}
+
+// WithUnit returns option. the option sets `unit` for numeric custom field.
+func (*CustomFieldOptionService) WithUnit(unit string) CustomFieldOption {
+	return CustomFieldOption(withUnit(unit))
+}
+
+// WithMinDate returns option. the option sets `min` for date custom field.
+func (*CustomFieldOptionService) WithMinDate(date Date) CustomFieldOption {
+	return CustomFieldOption(withMinDate(date))
+}
+
+// WithMaxDate returns option. the option sets `max` for date custom field.
+func (*CustomFieldOptionService) WithMaxDate(date Date) CustomFieldOption {
+	return CustomFieldOption(withMaxDate(date))
+}
+
+// WithInitialValueType returns option. the option sets `initialValueType` for date custom field.
+// 1: today, 2: today plus `initialShift` days, 3: `initialDate`.
+func (*CustomFieldOptionService) WithInitialValueType(valueType int) CustomFieldOption {
+	return CustomFieldOption(withInitialValueType(valueType))
+}
+
+// WithInitialDate returns option. the option sets `initialDate` for date custom field.
+func (*CustomFieldOptionService) WithInitialDate(date Date) CustomFieldOption {
+	return CustomFieldOption(withInitialDate(date))
+}
+
+// WithInitialShift returns option. the option sets `initialShift` for date custom field.
+func (*CustomFieldOptionService) WithInitialShift(days int) CustomFieldOption {
+	return CustomFieldOption(withInitialShift(days))
+}
+
+// WithItems returns option. the option sets `items[]` for list type custom field.
+func (*CustomFieldOptionService) WithItems(items []string) CustomFieldOption {
+	return CustomFieldOption(withItems(items))
+}
+
+// WithAllowInput returns option. the option sets `allowInput` for list type custom field.
+func (*CustomFieldOptionService) WithAllowInput(enabeld bool) CustomFieldOption {
+	return CustomFieldOption(withAllowInput(enabeld))
+}
+
+// WithAllowAddItem returns option. the option sets `allowAddItem` for list type custom field.
+func (*CustomFieldOptionService) WithAllowAddItem(enabeld bool) CustomFieldOption {
+	return CustomFieldOption(withAllowAddItem(enabeld))
+}
+
+// PullRequestListOption is type of functional option for listing and counting pull requests.
+type PullRequestListOption option
+
+// PullRequestOption is type of functional option for creating and updating pull requests.
+type PullRequestOption option
+
+// PullRequestOptionService has methods to make functional option for PullRequestService.
+type PullRequestOptionService struct {
+}
+
+// WithStatusIDs returns option. the option sets `statusId[]` for pull request list.
+func (*PullRequestOptionService) WithStatusIDs(ids []int) PullRequestListOption {
+	return PullRequestListOption(withStatusIDs(ids))
+}
+
+// WithAssigneeIDs returns option. the option sets `assigneeId[]` for pull request list.
+func (*PullRequestOptionService) WithAssigneeIDs(ids []int) PullRequestListOption {
+	return PullRequestListOption(withAssigneeIDs(ids))
+}
+
+// WithIssueIDs returns option. the option sets `issueId[]` for pull request list.
+func (*PullRequestOptionService) WithIssueIDs(ids []int) PullRequestListOption {
+	return PullRequestListOption(withIssueIDs(ids))
+}
+
+// WithCreatedUserIDs returns option. the option sets `createdUserId[]` for pull request list.
+func (*PullRequestOptionService) WithCreatedUserIDs(ids []int) PullRequestListOption {
+	return PullRequestListOption(withCreatedUserIDs(ids))
+}
+
+// WithOffset returns option. the option sets `offset` for pull request list.
+func (*PullRequestOptionService) WithOffset(offset int) PullRequestListOption {
+	return PullRequestListOption(withOffset(offset))
+}
+
+// WithCount returns option. the option sets `count` for pull request list.
+func (*PullRequestOptionService) WithCount(count int) PullRequestListOption {
+	return PullRequestListOption(withCount(count))
+}
+
+// WithSummary returns option. the option sets `summary` for pull request.
+func (*PullRequestOptionService) WithSummary(summary string) PullRequestOption {
+	return PullRequestOption(withSummary(summary))
+}
+
+// WithDescription returns option. the option sets `description` for pull request.
+func (*PullRequestOptionService) WithDescription(description string) PullRequestOption {
+	return PullRequestOption(withDescription(description))
+}
+
+// WithIssueID returns option. the option sets `issueId` for pull request.
+func (*PullRequestOptionService) WithIssueID(id int) PullRequestOption {
+	return PullRequestOption(withIssueID(id))
+}
+
+// WithAssigneeID returns option. the option sets `assigneeId` for pull request.
+func (*PullRequestOptionService) WithAssigneeID(id int) PullRequestOption {
+	return PullRequestOption(withAssigneeID(id))
+}
+
+// WithNotifiedUserIDs returns option. the option sets `notifiedUserId[]` for pull request.
+func (*PullRequestOptionService) WithNotifiedUserIDs(ids []int) PullRequestOption {
+	return PullRequestOption(withNotifiedUserIDs(ids))
+}
+
+// WithAttachmentIDs returns option. the option sets `attachmentId[]` for pull request.
+func (*PullRequestOptionService) WithAttachmentIDs(ids []int) PullRequestOption {
+	return PullRequestOption(withAttachmentIDs(ids))
+}
+
+// WithComment returns option. the option sets `comment` for pull request.
+func (*PullRequestOptionService) WithComment(comment string) PullRequestOption {
+	return PullRequestOption(withComment(comment))
+}
+
+// UploadOption is type of functional option for uploading files from io.Reader.
+type UploadOption func(f *uploadFile) error
+
+// UploadOptionService has methods to make functional option for uploading files from io.Reader.
+type UploadOptionService struct {
+}
+
+// WithSize returns option. the option sets size of the file.
+// The request has Content-Length header if the size is set, otherwise it is sent with chunked encoding.
+func (*UploadOptionService) WithSize(size int64) UploadOption {
+	return func(f *uploadFile) error {
+		if size < 0 {
+			return fmt.Errorf("size must not be negative: %d", size)
+		}
+		f.size = size
+		return nil
+	}
+}
+
+// WithContentType returns option. the option sets content type of the file.
+// The default is application/octet-stream.
+func (*UploadOptionService) WithContentType(contentType string) UploadOption {
+	return func(f *uploadFile) error {
+		if contentType == "" {
+			return errors.New("contentType must not be empty")
+		}
+		f.contentType = contentType
+		return nil
+	}
+}
+
+// WithProgress returns option. the option sets callback to report progress of uploading.
+// The callback is called with the number of bytes read from the reader and the size of the file,
+// which is -1 if the size is not set.
+func (*UploadOptionService) WithProgress(progress func(written, total int64)) UploadOption {
+	return func(f *uploadFile) error {
+		if progress == nil {
+			return errors.New("progress must not be nil")
+		}
+		f.progress = progress
+		return nil
+	}
 }
@@ -3,6 +3,7 @@ package backlog_test
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/nattokin/go-backlog"
 	"github.com/stretchr/testify/assert"
@@ -713,3 +714,650 @@ func TestWikiOptionService_WithMailNotify(t *testing.T) {
 		})
 	}
 }
+
+func TestIssueListOptionService_WithProjectIDs(t *testing.T) {
+	o := backlog.IssueListOptionService{}
+
+	cases := map[string]struct {
+		ids       []int
+		want      []string
+		wantError bool
+	}{
+		"valid-1": {
+			ids:       []int{1},
+			want:      []string{"1"},
+			wantError: false,
+		},
+		"valid-2": {
+			ids:       []int{1, 2, 3},
+			want:      []string{"1", "2", "3"},
+			wantError: false,
+		},
+		"invalid-1": {
+			ids:       []int{0},
+			want:      nil,
+			wantError: true,
+		},
+		"invalid-2": {
+			ids:       []int{1, -1},
+			want:      nil,
+			wantError: true,
+		},
+		"empty": {
+			ids:       []int{},
+			want:      nil,
+			wantError: false,
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			option := o.WithProjectIDs(tc.ids)
+			params := backlog.ExportNewRequestParams()
+
+			if err := option(params); tc.wantError {
+				assert.Error(t, err)
+				v := *params.ExportURLValues()
+				assert.Nil(t, v["projectId[]"])
+			} else {
+				assert.NoError(t, err)
+				v := *params.ExportURLValues()
+				assert.Equal(t, tc.want, v["projectId[]"])
+			}
+		})
+	}
+}
+
+func TestIssueListOptionService_WithParentChild(t *testing.T) {
+	o := backlog.IssueListOptionService{}
+
+	cases := map[string]struct {
+		parentChild backlog.ExportParentChild
+		want        string
+		wantError   bool
+	}{
+		"All": {
+			parentChild: backlog.ParentChildAll,
+			want:        "0",
+			wantError:   false,
+		},
+		"Parent": {
+			parentChild: backlog.ParentChildParent,
+			want:        "4",
+			wantError:   false,
+		},
+		"invalid-1": {
+			parentChild: backlog.ExportParentChild(-1),
+			wantError:   true,
+		},
+		"invalid-2": {
+			parentChild: backlog.ExportParentChild(5),
+			wantError:   true,
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			option := o.WithParentChild(tc.parentChild)
+			params := backlog.ExportNewRequestParams()
+
+			if err := option(params); tc.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, params.Get("parentChild"))
+			}
+		})
+	}
+}
+
+func TestIssueListOptionService_WithSort(t *testing.T) {
+	o := backlog.IssueListOptionService{}
+
+	cases := map[string]struct {
+		sort      backlog.ExportIssueSort
+		want      string
+		wantError bool
+	}{
+		"Updated": {
+			sort:      backlog.SortUpdated,
+			want:      "updated",
+			wantError: false,
+		},
+		"ChildIssue": {
+			sort:      backlog.SortChildIssue,
+			want:      "childIssue",
+			wantError: false,
+		},
+		"invalid": {
+			sort:      backlog.ExportIssueSort("test"),
+			wantError: true,
+		},
+		"empty": {
+			sort:      backlog.ExportIssueSort(""),
+			wantError: true,
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			option := o.WithSort(tc.sort)
+			params := backlog.ExportNewRequestParams()
+
+			if err := option(params); tc.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, params.Get("sort"))
+			}
+		})
+	}
+}
+
+func TestIssueListOptionService_WithOffset(t *testing.T) {
+	o := backlog.IssueListOptionService{}
+
+	cases := map[string]struct {
+		offset    int
+		wantError bool
+	}{
+		"zero": {
+			offset:    0,
+			wantError: false,
+		},
+		"valid": {
+			offset:    100,
+			wantError: false,
+		},
+		"invalid": {
+			offset:    -1,
+			wantError: true,
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			option := o.WithOffset(tc.offset)
+			params := backlog.ExportNewRequestParams()
+
+			if err := option(params); tc.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, strconv.Itoa(tc.offset), params.Get("offset"))
+			}
+		})
+	}
+}
+
+func TestIssueListOptionService_WithDueDateUntil(t *testing.T) {
+	o := backlog.IssueListOptionService{}
+
+	cases := map[string]struct {
+		until     backlog.Date
+		want      string
+		wantError bool
+	}{
+		"valid": {
+			until:     backlog.NewDate(2020, time.February, 29),
+			want:      "2020-02-29",
+			wantError: false,
+		},
+		"zero": {
+			until:     backlog.Date{},
+			wantError: true,
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			option := o.WithDueDateUntil(tc.until)
+			params := backlog.ExportNewRequestParams()
+
+			if err := option(params); tc.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, params.Get("dueDateUntil"))
+			}
+		})
+	}
+}
+
+func TestIssueOptionService_WithEstimatedHours(t *testing.T) {
+	o := backlog.IssueOptionService{}
+
+	cases := map[string]struct {
+		hours     float64
+		want      string
+		wantError bool
+	}{
+		"zero": {
+			hours:     0,
+			want:      "0",
+			wantError: false,
+		},
+		"fraction": {
+			hours:     2.25,
+			want:      "2.25",
+			wantError: false,
+		},
+		"negative": {
+			hours:     -0.5,
+			wantError: true,
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			option := o.WithEstimatedHours(tc.hours)
+			params := backlog.ExportNewRequestParams()
+
+			if err := option(params); tc.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, params.Get("estimatedHours"))
+			}
+		})
+	}
+}
+
+func TestIssueListOptionService_WithCustomField(t *testing.T) {
+	o := backlog.IssueListOptionService{}
+	date := backlog.NewDate(2020, time.April, 1)
+
+	cases := map[string]struct {
+		option    backlog.IssueListOption
+		key       string
+		want      []string
+		wantError bool
+	}{
+		"Keyword": {
+			option: o.WithCustomFieldKeyword(3, "crash"),
+			key:    "customField_3",
+			want:   []string{"crash"},
+		},
+		"Keyword_empty": {
+			option:    o.WithCustomFieldKeyword(3, ""),
+			wantError: true,
+		},
+		"NumberMin": {
+			option: o.WithCustomFieldNumberMin(4, 1.5),
+			key:    "customField_4_min",
+			want:   []string{"1.5"},
+		},
+		"NumberMax": {
+			option: o.WithCustomFieldNumberMax(4, 10),
+			key:    "customField_4_max",
+			want:   []string{"10"},
+		},
+		"DateSince": {
+			option: o.WithCustomFieldDateSince(5, date),
+			key:    "customField_5_min",
+			want:   []string{"2020-04-01"},
+		},
+		"DateUntil": {
+			option: o.WithCustomFieldDateUntil(5, date),
+			key:    "customField_5_max",
+			want:   []string{"2020-04-01"},
+		},
+		"Items": {
+			option: o.WithCustomFieldItems(6, []int{1, 2}),
+			key:    "customField_6[]",
+			want:   []string{"1", "2"},
+		},
+		"Items_invalid": {
+			option:    o.WithCustomFieldItems(6, []int{0}),
+			wantError: true,
+		},
+		"invalid_customFieldID": {
+			option:    o.WithCustomFieldNumberMin(0, 1),
+			wantError: true,
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			params := backlog.ExportNewRequestParams()
+
+			if err := tc.option(params); tc.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				v := *params.ExportURLValues()
+				assert.Equal(t, tc.want, v[tc.key])
+			}
+		})
+	}
+}
+
+func TestIssueOptionService_WithCustomFieldValue(t *testing.T) {
+	o := backlog.IssueOptionService{}
+
+	cases := map[string]struct {
+		options   []backlog.IssueOption
+		want      map[string][]string
+		wantError bool
+	}{
+		"Text": {
+			options: []backlog.IssueOption{o.WithCustomFieldTextValue(1, "hello")},
+			want:    map[string][]string{"customField_1": {"hello"}},
+		},
+		"Text_empty": {
+			options: []backlog.IssueOption{o.WithCustomFieldTextValue(1, "")},
+			want:    map[string][]string{"customField_1": {""}},
+		},
+		"Number": {
+			options: []backlog.IssueOption{o.WithCustomFieldNumberValue(3, 12.5)},
+			want:    map[string][]string{"customField_3": {"12.5"}},
+		},
+		"Date": {
+			options: []backlog.IssueOption{o.WithCustomFieldDateValue(4, backlog.NewDate(2020, time.April, 1))},
+			want:    map[string][]string{"customField_4": {"2020-04-01"}},
+		},
+		"Date_zero": {
+			options:   []backlog.IssueOption{o.WithCustomFieldDateValue(4, backlog.Date{})},
+			wantError: true,
+		},
+		"Item": {
+			options: []backlog.IssueOption{o.WithCustomFieldItemValue(5, 2)},
+			want:    map[string][]string{"customField_5": {"2"}},
+		},
+		"Item_invalid": {
+			options:   []backlog.IssueOption{o.WithCustomFieldItemValue(5, 0)},
+			wantError: true,
+		},
+		"Items_withOtherValue": {
+			options: []backlog.IssueOption{
+				o.WithCustomFieldItemsValue(6, []int{1, 2}),
+				o.WithCustomFieldOtherValue(6, "Linux"),
+			},
+			want: map[string][]string{
+				"customField_6":            {"1", "2"},
+				"customField_6_otherValue": {"Linux"},
+			},
+		},
+		"Items_invalid": {
+			options:   []backlog.IssueOption{o.WithCustomFieldItemsValue(6, []int{1, -1})},
+			wantError: true,
+		},
+		"invalid_customFieldID": {
+			options:   []backlog.IssueOption{o.WithCustomFieldTextValue(0, "hello")},
+			wantError: true,
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			params := backlog.ExportNewRequestParams()
+
+			var err error
+			for _, option := range tc.options {
+				if err = option(params); err != nil {
+					break
+				}
+			}
+			if tc.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				for k, v := range tc.want {
+					assert.Equal(t, v, (*params.ExportURLValues())[k])
+				}
+			}
+		})
+	}
+}
+
+func TestIssueOptionService_WithOptional(t *testing.T) {
+	o := backlog.IssueOptionService{}
+
+	cases := map[string]struct {
+		option    backlog.IssueOption
+		key       string
+		want      []string
+		wantError bool
+	}{
+		"dueDate_unset": {
+			option: o.WithOptionalDueDate(backlog.OptionalDate{}),
+			key:    "dueDate",
+			want:   nil,
+		},
+		"dueDate_set": {
+			option: o.WithOptionalDueDate(backlog.SetDate(backlog.NewDate(2020, time.April, 1))),
+			key:    "dueDate",
+			want:   []string{"2020-04-01"},
+		},
+		"dueDate_clear": {
+			option: o.WithOptionalDueDate(backlog.ClearDate()),
+			key:    "dueDate",
+			want:   []string{""},
+		},
+		"dueDate_set_zero": {
+			option:    o.WithOptionalDueDate(backlog.SetDate(backlog.Date{})),
+			wantError: true,
+		},
+		"startDate_clear": {
+			option: o.WithOptionalStartDate(backlog.ClearDate()),
+			key:    "startDate",
+			want:   []string{""},
+		},
+		"assigneeId_set": {
+			option: o.WithOptionalAssigneeID(backlog.SetInt(5)),
+			key:    "assigneeId",
+			want:   []string{"5"},
+		},
+		"assigneeId_clear": {
+			option: o.WithOptionalAssigneeID(backlog.ClearInt()),
+			key:    "assigneeId",
+			want:   []string{""},
+		},
+		"assigneeId_set_invalied": {
+			option:    o.WithOptionalAssigneeID(backlog.SetInt(0)),
+			wantError: true,
+		},
+		"parentIssueId_clear": {
+			option: o.WithOptionalParentIssueID(backlog.ClearInt()),
+			key:    "parentIssueId",
+			want:   []string{""},
+		},
+		"resolutionId_unset": {
+			option: o.WithOptionalResolutionID(backlog.OptionalInt{}),
+			key:    "resolutionId",
+			want:   nil,
+		},
+		"estimatedHours_set_zero": {
+			option: o.WithOptionalEstimatedHours(backlog.SetFloat(0)),
+			key:    "estimatedHours",
+			want:   []string{"0"},
+		},
+		"actualHours_clear": {
+			option: o.WithOptionalActualHours(backlog.ClearFloat()),
+			key:    "actualHours",
+			want:   []string{""},
+		},
+		"description_clear": {
+			option: o.WithOptionalDescription(backlog.ClearString()),
+			key:    "description",
+			want:   []string{""},
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			params := backlog.ExportNewRequestParams()
+
+			if err := tc.option(params); tc.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				v := *params.ExportURLValues()
+				assert.Equal(t, tc.want, v[tc.key])
+			}
+		})
+	}
+}
+
+func TestProjectOptionService_WithOptional(t *testing.T) {
+	o := backlog.ProjectOptionService{}
+
+	cases := map[string]struct {
+		option    backlog.ProjectOption
+		key       string
+		want      []string
+		wantError bool
+	}{
+		"archived_unset": {
+			option: o.WithOptionalArchived(backlog.OptionalBool{}),
+			key:    "archived",
+			want:   nil,
+		},
+		"archived_false": {
+			option: o.WithOptionalArchived(backlog.SetBool(false)),
+			key:    "archived",
+			want:   []string{"false"},
+		},
+		"chartEnabled_true": {
+			option: o.WithOptionalChartEnabled(backlog.SetBool(true)),
+			key:    "chartEnabled",
+			want:   []string{"true"},
+		},
+		"subtaskingEnabled_false": {
+			option: o.WithOptionalSubtaskingEnabled(backlog.SetBool(false)),
+			key:    "subtaskingEnabled",
+			want:   []string{"false"},
+		},
+		"projectLeaderCanEditProjectLeader_true": {
+			option: o.WithOptionalProjectLeaderCanEditProjectLeader(backlog.SetBool(true)),
+			key:    "projectLeaderCanEditProjectLeader",
+			want:   []string{"true"},
+		},
+		"name_set": {
+			option: o.WithOptionalName(backlog.SetString("test")),
+			key:    "name",
+			want:   []string{"test"},
+		},
+		"name_clear": {
+			option:    o.WithOptionalName(backlog.ClearString()),
+			wantError: true,
+		},
+		"key_set_empty": {
+			option:    o.WithOptionalKey(backlog.SetString("")),
+			wantError: true,
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			params := backlog.ExportNewRequestParams()
+
+			if err := tc.option(params); tc.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				v := *params.ExportURLValues()
+				assert.Equal(t, tc.want, v[tc.key])
+			}
+		})
+	}
+}
+
+func TestWikiOptionService_WithOptional(t *testing.T) {
+	o := backlog.WikiOptionService{}
+
+	cases := map[string]struct {
+		option    backlog.WikiOption
+		key       string
+		want      []string
+		wantError bool
+	}{
+		"content_clear": {
+			option: o.WithOptionalContent(backlog.ClearString()),
+			key:    "content",
+			want:   []string{""},
+		},
+		"content_set": {
+			option: o.WithOptionalContent(backlog.SetString("content")),
+			key:    "content",
+			want:   []string{"content"},
+		},
+		"name_clear": {
+			option:    o.WithOptionalName(backlog.ClearString()),
+			wantError: true,
+		},
+		"mailNotify_unset": {
+			option: o.WithOptionalMailNotify(backlog.OptionalBool{}),
+			key:    "mailNotify",
+			want:   nil,
+		},
+		"mailNotify_false": {
+			option: o.WithOptionalMailNotify(backlog.SetBool(false)),
+			key:    "mailNotify",
+			want:   []string{"false"},
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			params := backlog.ExportNewRequestParams()
+
+			if err := tc.option(params); tc.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				v := *params.ExportURLValues()
+				assert.Equal(t, tc.want, v[tc.key])
+			}
+		})
+	}
+}
+
+func TestUserOptionService_WithOptional(t *testing.T) {
+	o := backlog.UserOptionService{}
+
+	cases := map[string]struct {
+		option    backlog.UserOption
+		key       string
+		want      []string
+		wantError bool
+	}{
+		"name_unset": {
+			option: o.WithOptionalName(backlog.OptionalString{}),
+			key:    "name",
+			want:   nil,
+		},
+		"name_set": {
+			option: o.WithOptionalName(backlog.SetString("admin")),
+			key:    "name",
+			want:   []string{"admin"},
+		},
+		"password_clear": {
+			option:    o.WithOptionalPassword(backlog.ClearString()),
+			wantError: true,
+		},
+		"mailAddress_clear": {
+			option:    o.WithOptionalMailAddress(backlog.ClearString()),
+			wantError: true,
+		},
+		"mailAddress_set": {
+			option: o.WithOptionalMailAddress(backlog.SetString("admin@example.com")),
+			key:    "mailAddress",
+			want:   []string{"admin@example.com"},
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			params := backlog.ExportNewRequestParams()
+
+			if err := tc.option(params); tc.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				v := *params.ExportURLValues()
+				assert.Equal(t, tc.want, v[tc.key])
+			}
+		})
+	}
+}
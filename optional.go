@@ -0,0 +1,161 @@
+package backlog
+
+// optionalState is state of optional values.
+type optionalState int
+
+const (
+	optionalUnset optionalState = iota
+	optionalSet
+	optionalClear
+)
+
+// OptionalString is a string field of update requests.
+// The zero value leaves the field unchanged.
+type OptionalString struct {
+	state optionalState
+	value string
+}
+
+// SetString returns OptionalString which sets v to the field.
+func SetString(v string) OptionalString {
+	return OptionalString{state: optionalSet, value: v}
+}
+
+// ClearString returns OptionalString which clears the field.
+func ClearString() OptionalString {
+	return OptionalString{state: optionalClear}
+}
+
+// IsUnset reports whether the field is left unchanged.
+func (o OptionalString) IsUnset() bool {
+	return o.state == optionalUnset
+}
+
+// IsClear reports whether the field is cleared.
+func (o OptionalString) IsClear() bool {
+	return o.state == optionalClear
+}
+
+// Value returns the value and whether it is set.
+func (o OptionalString) Value() (string, bool) {
+	return o.value, o.state == optionalSet
+}
+
+// OptionalInt is an integer field, such as ID, of update requests.
+// The zero value leaves the field unchanged.
+type OptionalInt struct {
+	state optionalState
+	value int
+}
+
+// SetInt returns OptionalInt which sets v to the field.
+func SetInt(v int) OptionalInt {
+	return OptionalInt{state: optionalSet, value: v}
+}
+
+// ClearInt returns OptionalInt which clears the field.
+func ClearInt() OptionalInt {
+	return OptionalInt{state: optionalClear}
+}
+
+// IsUnset reports whether the field is left unchanged.
+func (o OptionalInt) IsUnset() bool {
+	return o.state == optionalUnset
+}
+
+// IsClear reports whether the field is cleared.
+func (o OptionalInt) IsClear() bool {
+	return o.state == optionalClear
+}
+
+// Value returns the value and whether it is set.
+func (o OptionalInt) Value() (int, bool) {
+	return o.value, o.state == optionalSet
+}
+
+// OptionalFloat is a numeric field, such as hours, of update requests.
+// The zero value leaves the field unchanged.
+type OptionalFloat struct {
+	state optionalState
+	value float64
+}
+
+// SetFloat returns OptionalFloat which sets v to the field.
+func SetFloat(v float64) OptionalFloat {
+	return OptionalFloat{state: optionalSet, value: v}
+}
+
+// ClearFloat returns OptionalFloat which clears the field.
+func ClearFloat() OptionalFloat {
+	return OptionalFloat{state: optionalClear}
+}
+
+// IsUnset reports whether the field is left unchanged.
+func (o OptionalFloat) IsUnset() bool {
+	return o.state == optionalUnset
+}
+
+// IsClear reports whether the field is cleared.
+func (o OptionalFloat) IsClear() bool {
+	return o.state == optionalClear
+}
+
+// Value returns the value and whether it is set.
+func (o OptionalFloat) Value() (float64, bool) {
+	return o.value, o.state == optionalSet
+}
+
+// OptionalBool is a boolean field of update requests.
+// The zero value leaves the field unchanged.
+// Boolean fields can not be cleared.
+type OptionalBool struct {
+	state optionalState
+	value bool
+}
+
+// SetBool returns OptionalBool which sets v to the field.
+func SetBool(v bool) OptionalBool {
+	return OptionalBool{state: optionalSet, value: v}
+}
+
+// IsUnset reports whether the field is left unchanged.
+func (o OptionalBool) IsUnset() bool {
+	return o.state == optionalUnset
+}
+
+// Value returns the value and whether it is set.
+func (o OptionalBool) Value() (bool, bool) {
+	return o.value, o.state == optionalSet
+}
+
+// OptionalDate is a date field of update requests.
+// The zero value leaves the field unchanged.
+type OptionalDate struct {
+	state optionalState
+	value Date
+}
+
+// SetDate returns OptionalDate which sets v to the field.
+func SetDate(v Date) OptionalDate {
+	return OptionalDate{state: optionalSet, value: v}
+}
+
+// ClearDate returns OptionalDate which clears the field.
+func ClearDate() OptionalDate {
+	return OptionalDate{state: optionalClear}
+}
+
+// IsUnset reports whether the field is left unchanged.
+func (o OptionalDate) IsUnset() bool {
+	return o.state == optionalUnset
+}
+
+// IsClear reports whether the field is cleared.
+func (o OptionalDate) IsClear() bool {
+	return o.state == optionalClear
+}
+
+// Value returns the value and whether it is set.
+func (o OptionalDate) Value() (Date, bool) {
+	return o.value, o.state == optionalSet
+}
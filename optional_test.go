@@ -0,0 +1,74 @@
+package backlog_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionalString(t *testing.T) {
+	var unset backlog.OptionalString
+	assert.True(t, unset.IsUnset())
+	assert.False(t, unset.IsClear())
+	_, ok := unset.Value()
+	assert.False(t, ok)
+
+	set := backlog.SetString("")
+	assert.False(t, set.IsUnset())
+	assert.False(t, set.IsClear())
+	v, ok := set.Value()
+	assert.True(t, ok)
+	assert.Equal(t, "", v)
+
+	clear := backlog.ClearString()
+	assert.False(t, clear.IsUnset())
+	assert.True(t, clear.IsClear())
+	_, ok = clear.Value()
+	assert.False(t, ok)
+}
+
+func TestOptionalInt(t *testing.T) {
+	var unset backlog.OptionalInt
+	assert.True(t, unset.IsUnset())
+
+	v, ok := backlog.SetInt(0).Value()
+	assert.True(t, ok)
+	assert.Equal(t, 0, v)
+
+	assert.True(t, backlog.ClearInt().IsClear())
+}
+
+func TestOptionalFloat(t *testing.T) {
+	var unset backlog.OptionalFloat
+	assert.True(t, unset.IsUnset())
+
+	v, ok := backlog.SetFloat(1.5).Value()
+	assert.True(t, ok)
+	assert.Equal(t, 1.5, v)
+
+	assert.True(t, backlog.ClearFloat().IsClear())
+}
+
+func TestOptionalBool(t *testing.T) {
+	var unset backlog.OptionalBool
+	assert.True(t, unset.IsUnset())
+	_, ok := unset.Value()
+	assert.False(t, ok)
+
+	v, ok := backlog.SetBool(false).Value()
+	assert.True(t, ok)
+	assert.False(t, v)
+}
+
+func TestOptionalDate(t *testing.T) {
+	var unset backlog.OptionalDate
+	assert.True(t, unset.IsUnset())
+
+	v, ok := backlog.SetDate(backlog.NewDate(2020, time.April, 1)).Value()
+	assert.True(t, ok)
+	assert.Equal(t, "2020-04-01", v.String())
+
+	assert.True(t, backlog.ClearDate().IsClear())
+}
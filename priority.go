@@ -1,6 +1,34 @@
 package backlog
 
+import (
+	"context"
+	"encoding/json"
+)
+
 // PriorityService has methods for Priority.
 type PriorityService struct {
 	method *method
 }
+
+// All returns a list of priorities.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-priority-list
+func (s *PriorityService) All() ([]*Priority, error) {
+	return s.AllContext(context.Background())
+}
+
+// AllContext is like All but with the context.
+func (s *PriorityService) AllContext(ctx context.Context) ([]*Priority, error) {
+	resp, err := s.method.Get(ctx, "priorities", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := []*Priority{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
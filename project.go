@@ -1,6 +1,7 @@
 package backlog
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"strconv"
@@ -35,19 +36,29 @@ func (k ProjectKey) getProjectIDOrKey() (string, error) {
 type ProjectService struct {
 	method *method
 
-	Activity *ProjectActivityService
-	User     *ProjectUserService
-	Option   *ProjectOptionService
+	Activity    *ProjectActivityService
+	Category    *CategoryService
+	CustomField *CustomFieldService
+	IssueType   *IssueTypeService
+	Status      *StatusService
+	User        *ProjectUserService
+	Version     *VersionService
+	Option      *ProjectOptionService
 }
 
 // Joined returns all of joining projects.
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-project-list
 func (s *ProjectService) Joined() ([]*Project, error) {
+	return s.JoinedContext(context.Background())
+}
+
+// JoinedContext is like Joined but with the context.
+func (s *ProjectService) JoinedContext(ctx context.Context) ([]*Project, error) {
 	params := newRequestParams()
 	params.Set("all", "false")
 
-	return s.getList(params)
+	return s.getList(ctx, params)
 }
 
 // All returns all of projects. This is limited to admin.
@@ -55,21 +66,31 @@ func (s *ProjectService) Joined() ([]*Project, error) {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-project-list
 func (s *ProjectService) All() ([]*Project, error) {
+	return s.AllContext(context.Background())
+}
+
+// AllContext is like All but with the context.
+func (s *ProjectService) AllContext(ctx context.Context) ([]*Project, error) {
 	params := newRequestParams()
 	params.Set("all", "true")
 
-	return s.getList(params)
+	return s.getList(ctx, params)
 }
 
 // Archived returns all of joining projects archived.
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-project-list
 func (s *ProjectService) Archived() ([]*Project, error) {
+	return s.ArchivedContext(context.Background())
+}
+
+// ArchivedContext is like Archived but with the context.
+func (s *ProjectService) ArchivedContext(ctx context.Context) ([]*Project, error) {
 	params := newRequestParams()
 	params.Set("archived", "true")
 	params.Set("all", "false")
 
-	return s.getList(params)
+	return s.getList(ctx, params)
 }
 
 // AllArchived returns all of projects archived.
@@ -77,11 +98,16 @@ func (s *ProjectService) Archived() ([]*Project, error) {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-project-list
 func (s *ProjectService) AllArchived() ([]*Project, error) {
+	return s.AllArchivedContext(context.Background())
+}
+
+// AllArchivedContext is like AllArchived but with the context.
+func (s *ProjectService) AllArchivedContext(ctx context.Context) ([]*Project, error) {
 	params := newRequestParams()
 	params.Set("archived", "true")
 	params.Set("all", "true")
 
-	return s.getList(params)
+	return s.getList(ctx, params)
 }
 
 // Unarchived returns all of joining projects unarchived.
@@ -89,11 +115,16 @@ func (s *ProjectService) AllArchived() ([]*Project, error) {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-project-list
 func (s *ProjectService) Unarchived() ([]*Project, error) {
+	return s.UnarchivedContext(context.Background())
+}
+
+// UnarchivedContext is like Unarchived but with the context.
+func (s *ProjectService) UnarchivedContext(ctx context.Context) ([]*Project, error) {
 	params := newRequestParams()
 	params.Set("archived", "false")
 	params.Set("all", "false")
 
-	return s.getList(params)
+	return s.getList(ctx, params)
 }
 
 // AllUnarchived returns all of projects unarchived.
@@ -101,15 +132,20 @@ func (s *ProjectService) Unarchived() ([]*Project, error) {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-project-list
 func (s *ProjectService) AllUnarchived() ([]*Project, error) {
+	return s.AllUnarchivedContext(context.Background())
+}
+
+// AllUnarchivedContext is like AllUnarchived but with the context.
+func (s *ProjectService) AllUnarchivedContext(ctx context.Context) ([]*Project, error) {
 	params := newRequestParams()
 	params.Set("archived", "false")
 	params.Set("all", "true")
 
-	return s.getList(params)
+	return s.getList(ctx, params)
 }
 
-func (s *ProjectService) getList(params *requestParams) ([]*Project, error) {
-	resp, err := s.method.Get("projects", params)
+func (s *ProjectService) getList(ctx context.Context, params *requestParams) ([]*Project, error) {
+	resp, err := s.method.Get(ctx, "projects", params)
 	if err != nil {
 		return nil, err
 	}
@@ -127,12 +163,17 @@ func (s *ProjectService) getList(params *requestParams) ([]*Project, error) {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-project
 func (s *ProjectService) One(target ProjectIDOrKeyGetter) (*Project, error) {
+	return s.OneContext(context.Background(), target)
+}
+
+// OneContext is like One but with the context.
+func (s *ProjectService) OneContext(ctx context.Context, target ProjectIDOrKeyGetter) (*Project, error) {
 	projectIDOrKey, err := target.getProjectIDOrKey()
 	if err != nil {
 		return nil, err
 	}
 	spath := "projects/" + projectIDOrKey
-	resp, err := s.method.Get(spath, nil)
+	resp, err := s.method.Get(ctx, spath, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -150,6 +191,11 @@ func (s *ProjectService) One(target ProjectIDOrKeyGetter) (*Project, error) {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-project
 func (s *ProjectService) Create(key, name string, options ...ProjectOption) (*Project, error) {
+	return s.CreateContext(context.Background(), key, name, options...)
+}
+
+// CreateContext is like Create but with the context.
+func (s *ProjectService) CreateContext(ctx context.Context, key, name string, options ...ProjectOption) (*Project, error) {
 	if key == "" {
 		return nil, errors.New("key must not be empty")
 	}
@@ -170,7 +216,7 @@ func (s *ProjectService) Create(key, name string, options ...ProjectOption) (*Pr
 	params.Set("name", name)
 	params.Del("archived")
 
-	resp, err := s.method.Post("projects", params)
+	resp, err := s.method.Post(ctx, "projects", params)
 	if err != nil {
 		return nil, err
 	}
@@ -188,6 +234,11 @@ func (s *ProjectService) Create(key, name string, options ...ProjectOption) (*Pr
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-project
 func (s *ProjectService) Update(target ProjectIDOrKeyGetter, options ...ProjectOption) (*Project, error) {
+	return s.UpdateContext(context.Background(), target, options...)
+}
+
+// UpdateContext is like Update but with the context.
+func (s *ProjectService) UpdateContext(ctx context.Context, target ProjectIDOrKeyGetter, options ...ProjectOption) (*Project, error) {
 	projectIDOrKey, err := target.getProjectIDOrKey()
 	if err != nil {
 		return nil, err
@@ -200,7 +251,7 @@ func (s *ProjectService) Update(target ProjectIDOrKeyGetter, options ...ProjectO
 	}
 
 	spath := "projects/" + projectIDOrKey
-	resp, err := s.method.Patch(spath, params)
+	resp, err := s.method.Patch(ctx, spath, params)
 	if err != nil {
 		return nil, err
 	}
@@ -218,12 +269,17 @@ func (s *ProjectService) Update(target ProjectIDOrKeyGetter, options ...ProjectO
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-project
 func (s *ProjectService) Delete(target ProjectIDOrKeyGetter) (*Project, error) {
+	return s.DeleteContext(context.Background(), target)
+}
+
+// DeleteContext is like Delete but with the context.
+func (s *ProjectService) DeleteContext(ctx context.Context, target ProjectIDOrKeyGetter) (*Project, error) {
 	projectIDOrKey, err := target.getProjectIDOrKey()
 	if err != nil {
 		return nil, err
 	}
 	spath := "projects/" + projectIDOrKey
-	resp, err := s.method.Delete(spath, newRequestParams())
+	resp, err := s.method.Delete(ctx, spath, newRequestParams())
 	if err != nil {
 		return nil, err
 	}
@@ -246,7 +302,7 @@ func (s *ProjectService) Delete(target ProjectIDOrKeyGetter) (*Project, error) {
 // 		return nil, err
 // 	}
 // 	spath := "projects/" + projectIDOrKey + "/image"
-// 	resp, err := s.method.Get(spath, nil)
+// 	resp, err := s.method.Get(ctx, spath, nil)
 // 	if err != nil {
 // 		return nil, err
 // 	}
@@ -1,6 +1,7 @@
 package backlog_test
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"os"
@@ -29,7 +30,7 @@ func TestProjectService_Joined(t *testing.T) {
 	}
 	s := &backlog.ProjectService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			assert.Equal(t, want.all, params.Get("all"))
 			assert.Equal(t, want.archived, params.Get("archived"))
@@ -64,7 +65,7 @@ func TestProjectService_All(t *testing.T) {
 	}
 	s := &backlog.ProjectService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			assert.Equal(t, want.all, params.Get("all"))
 			assert.Equal(t, want.archived, params.Get("archived"))
@@ -99,7 +100,7 @@ func TestProjectService_Archived(t *testing.T) {
 	}
 	s := &backlog.ProjectService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			assert.Equal(t, want.all, params.Get("all"))
 			assert.Equal(t, want.archived, params.Get("archived"))
@@ -135,7 +136,7 @@ func TestProjectService_AllArchived(t *testing.T) {
 	}
 	s := &backlog.ProjectService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			assert.Equal(t, want.all, params.Get("all"))
 			assert.Equal(t, want.archived, params.Get("archived"))
@@ -171,7 +172,7 @@ func TestProjectService_Unarchived(t *testing.T) {
 	}
 	s := &backlog.ProjectService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			assert.Equal(t, want.all, params.Get("all"))
 			assert.Equal(t, want.archived, params.Get("archived"))
@@ -207,7 +208,7 @@ func TestProjectService_AllUnarchived(t *testing.T) {
 	}
 	s := &backlog.ProjectService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			assert.Equal(t, want.all, params.Get("all"))
 			assert.Equal(t, want.archived, params.Get("archived"))
@@ -242,7 +243,7 @@ func TestProjectService_GetList(t *testing.T) {
 
 	s := &backlog.ProjectService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -263,7 +264,7 @@ func TestProjectService_GetList(t *testing.T) {
 func TestProjectService_GetList_clientError(t *testing.T) {
 	s := &backlog.ProjectService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			return nil, errors.New("error")
 		},
 	})
@@ -290,7 +291,7 @@ func TestProjectService_One_key(t *testing.T) {
 	}
 	s := &backlog.ProjectService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			assert.Nil(t, params)
 			resp := &http.Response{
@@ -325,7 +326,7 @@ func TestProjectService_One_id(t *testing.T) {
 	}
 	s := &backlog.ProjectService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			assert.Nil(t, params)
 			resp := &http.Response{
@@ -350,7 +351,7 @@ func TestProjectService_One_key_error(t *testing.T) {
 
 	s := &backlog.ProjectService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -366,7 +367,7 @@ func TestProjectService_One_key_error(t *testing.T) {
 func TestProjectService_One_clientError(t *testing.T) {
 	s := &backlog.ProjectService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			return nil, errors.New("error")
 		},
 	})
@@ -383,7 +384,7 @@ func TestProjectService_One_invalidJson(t *testing.T) {
 
 	s := &backlog.ProjectService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -417,7 +418,7 @@ func TestProjectService_Create(t *testing.T) {
 	}
 	s := &backlog.ProjectService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Post: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			assert.NotNil(t, params)
 			assert.Equal(t, want.key, params.Get("key"))
@@ -468,7 +469,7 @@ func TestProjectService_Create_param(t *testing.T) {
 
 			s := &backlog.ProjectService{}
 			s.ExportSetMethod(&backlog.ExportMethod{
-				Post: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+				Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 					assert.Equal(t, tc.key, params.Get("key"))
 					assert.Equal(t, tc.name, params.Get("name"))
 
@@ -549,7 +550,7 @@ func TestProjectService_Create_option(t *testing.T) {
 
 			s := &backlog.ProjectService{}
 			s.ExportSetMethod(&backlog.ExportMethod{
-				Post: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+				Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 					assert.Equal(t, tc.want.chartEnabled, params.Get("chartEnabled"))
 					assert.Equal(t, tc.want.subtaskingEnabled, params.Get("subtaskingEnabled"))
 					assert.Equal(t, tc.want.projectLeaderCanEditProjectLeader, params.Get("projectLeaderCanEditProjectLeader"))
@@ -575,7 +576,7 @@ func TestProjectService_Create_option(t *testing.T) {
 func TestProjectService_Create_clientError(t *testing.T) {
 	s := &backlog.ProjectService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Post: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			return nil, errors.New("error")
 		},
 	})
@@ -592,7 +593,7 @@ func TestProjectService_Create_invalidJson(t *testing.T) {
 
 	s := &backlog.ProjectService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Post: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -623,7 +624,7 @@ func TestProjectService_Update(t *testing.T) {
 	}
 	s := &backlog.ProjectService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Patch: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			assert.NotNil(t, params)
 			resp := &http.Response{
@@ -671,7 +672,7 @@ func TestProjectService_Update_param(t *testing.T) {
 
 			s := &backlog.ProjectService{}
 			s.ExportSetMethod(&backlog.ExportMethod{
-				Patch: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+				Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 					resp := &http.Response{
 						StatusCode: http.StatusOK,
 						Body:       bj,
@@ -772,7 +773,7 @@ func TestProjectService_Update_option(t *testing.T) {
 
 			s := &backlog.ProjectService{}
 			s.ExportSetMethod(&backlog.ExportMethod{
-				Patch: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+				Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 					assert.Equal(t, tc.want.key, params.Get("key"))
 					assert.Equal(t, tc.want.name, params.Get("name"))
 					assert.Equal(t, tc.want.chartEnabled, params.Get("chartEnabled"))
@@ -801,7 +802,7 @@ func TestProjectService_Update_option(t *testing.T) {
 func TestProjectService_Update_clientError(t *testing.T) {
 	s := &backlog.ProjectService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Patch: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			return nil, errors.New("error")
 		},
 	})
@@ -818,7 +819,7 @@ func TestProjectService_Update_invalidJson(t *testing.T) {
 
 	s := &backlog.ProjectService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Patch: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -865,7 +866,7 @@ func TestProjectService_Delete_param(t *testing.T) {
 
 			s := &backlog.ProjectService{}
 			s.ExportSetMethod(&backlog.ExportMethod{
-				Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+				Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 					resp := &http.Response{
 						StatusCode: http.StatusOK,
 						Body:       bj,
@@ -900,7 +901,7 @@ func TestProjectService_Delete(t *testing.T) {
 	}
 	s := &backlog.ProjectService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			assert.NotNil(t, params)
 			resp := &http.Response{
@@ -918,7 +919,7 @@ func TestProjectService_Delete(t *testing.T) {
 func TestProjectService_Delete_clientError(t *testing.T) {
 	s := &backlog.ProjectService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			return nil, errors.New("error")
 		},
 	})
@@ -935,7 +936,7 @@ func TestProjectService_Delete_invalidJson(t *testing.T) {
 
 	s := &backlog.ProjectService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -1,8 +1,301 @@
 package backlog
 
-// PullRequestService has methods for Issue.
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+func decodePullRequest(resp *response) (*PullRequest, error) {
+	defer resp.Body.Close()
+
+	v := PullRequest{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+func pullRequestPath(target ProjectIDOrKeyGetter, repoIDOrName string, prNumber int) (string, error) {
+	if prNumber < 1 {
+		return "", fmt.Errorf("prNumber must be 1 or more: %d", prNumber)
+	}
+	spath, err := repositoryPath(target, repoIDOrName)
+	if err != nil {
+		return "", err
+	}
+	return spath + "/pullRequests/" + strconv.Itoa(prNumber), nil
+}
+
+// PullRequestService has methods for PullRequest.
 type PullRequestService struct {
 	method *method
 
 	Attachment *PullRequestAttachmentService
+	Comment    *PullRequestCommentService
+	Option     *PullRequestOptionService
+}
+
+// All returns a list of pull requests in the repository.
+//
+// This method supports options returned by methods in "*Client.PullRequest.Option".
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-pull-request-list
+func (s *PullRequestService) All(target ProjectIDOrKeyGetter, repoIDOrName string, options ...PullRequestListOption) ([]*PullRequest, error) {
+	return s.AllContext(context.Background(), target, repoIDOrName, options...)
+}
+
+// AllContext is like All but with the context.
+func (s *PullRequestService) AllContext(ctx context.Context, target ProjectIDOrKeyGetter, repoIDOrName string, options ...PullRequestListOption) ([]*PullRequest, error) {
+	spath, err := repositoryPath(target, repoIDOrName)
+	if err != nil {
+		return nil, err
+	}
+
+	params := newRequestParams()
+	for _, option := range options {
+		if err := option(params); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.method.Get(ctx, spath+"/pullRequests", params)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := []*PullRequest{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// Count returns the number of pull requests in the repository.
+//
+// This method supports options returned by methods in "*Client.PullRequest.Option".
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-number-of-pull-requests
+func (s *PullRequestService) Count(target ProjectIDOrKeyGetter, repoIDOrName string, options ...PullRequestListOption) (int, error) {
+	return s.CountContext(context.Background(), target, repoIDOrName, options...)
+}
+
+// CountContext is like Count but with the context.
+func (s *PullRequestService) CountContext(ctx context.Context, target ProjectIDOrKeyGetter, repoIDOrName string, options ...PullRequestListOption) (int, error) {
+	spath, err := repositoryPath(target, repoIDOrName)
+	if err != nil {
+		return 0, err
+	}
+
+	params := newRequestParams()
+	for _, option := range options {
+		if err := option(params); err != nil {
+			return 0, err
+		}
+	}
+
+	resp, err := s.method.Get(ctx, spath+"/pullRequests/count", params)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	v := map[string]int{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return 0, err
+	}
+
+	return v["count"], nil
+}
+
+// One returns one of the pull requests in the repository by number.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-pull-request
+func (s *PullRequestService) One(target ProjectIDOrKeyGetter, repoIDOrName string, prNumber int) (*PullRequest, error) {
+	return s.OneContext(context.Background(), target, repoIDOrName, prNumber)
+}
+
+// OneContext is like One but with the context.
+func (s *PullRequestService) OneContext(ctx context.Context, target ProjectIDOrKeyGetter, repoIDOrName string, prNumber int) (*PullRequest, error) {
+	spath, err := pullRequestPath(target, repoIDOrName, prNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.method.Get(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodePullRequest(resp)
+}
+
+// Create creates a new pull request which merges the branch into the base.
+//
+// This method supports options returned by methods in "*Client.PullRequest.Option".
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-pull-request
+func (s *PullRequestService) Create(target ProjectIDOrKeyGetter, repoIDOrName, summary, description, base, branch string, options ...PullRequestOption) (*PullRequest, error) {
+	return s.CreateContext(context.Background(), target, repoIDOrName, summary, description, base, branch, options...)
+}
+
+// CreateContext is like Create but with the context.
+func (s *PullRequestService) CreateContext(ctx context.Context, target ProjectIDOrKeyGetter, repoIDOrName, summary, description, base, branch string, options ...PullRequestOption) (*PullRequest, error) {
+	if summary == "" {
+		return nil, errors.New("summary is requierd")
+	}
+	if description == "" {
+		return nil, errors.New("description is requierd")
+	}
+	if base == "" {
+		return nil, errors.New("base is requierd")
+	}
+	if branch == "" {
+		return nil, errors.New("branch is requierd")
+	}
+	spath, err := repositoryPath(target, repoIDOrName)
+	if err != nil {
+		return nil, err
+	}
+
+	params := newRequestParams()
+	params.Set("summary", summary)
+	params.Set("description", description)
+	params.Set("base", base)
+	params.Set("branch", branch)
+
+	for _, option := range options {
+		if err := option(params); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.method.Post(ctx, spath+"/pullRequests", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodePullRequest(resp)
+}
+
+// Update updates the pull request.
+//
+// This method supports options returned by methods in "*Client.PullRequest.Option".
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-pull-request
+func (s *PullRequestService) Update(target ProjectIDOrKeyGetter, repoIDOrName string, prNumber int, options ...PullRequestOption) (*PullRequest, error) {
+	return s.UpdateContext(context.Background(), target, repoIDOrName, prNumber, options...)
+}
+
+// UpdateContext is like Update but with the context.
+func (s *PullRequestService) UpdateContext(ctx context.Context, target ProjectIDOrKeyGetter, repoIDOrName string, prNumber int, options ...PullRequestOption) (*PullRequest, error) {
+	if options == nil {
+		return nil, errors.New("requires one or more options")
+	}
+	spath, err := pullRequestPath(target, repoIDOrName, prNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	params := newRequestParams()
+	for _, option := range options {
+		if err := option(params); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.method.Patch(ctx, spath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodePullRequest(resp)
+}
+
+// PullRequestCommentService has methods for comments of pull request.
+type PullRequestCommentService struct {
+	method *method
+
+	Option *CommentOptionService
+}
+
+// List returns a list of comments in the pull request.
+//
+// This method supports options returned by methods in "*Client.PullRequest.Comment.Option".
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-pull-request-comment
+func (s *PullRequestCommentService) List(target ProjectIDOrKeyGetter, repoIDOrName string, prNumber int, options ...CommentListOption) ([]*Comment, error) {
+	return s.ListContext(context.Background(), target, repoIDOrName, prNumber, options...)
+}
+
+// ListContext is like List but with the context.
+func (s *PullRequestCommentService) ListContext(ctx context.Context, target ProjectIDOrKeyGetter, repoIDOrName string, prNumber int, options ...CommentListOption) ([]*Comment, error) {
+	spath, err := pullRequestPath(target, repoIDOrName, prNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return getCommentList(ctx, s.method.Get, spath+"/comments", options...)
+}
+
+// Count returns the number of comments in the pull request.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-number-of-pull-request-comments
+func (s *PullRequestCommentService) Count(target ProjectIDOrKeyGetter, repoIDOrName string, prNumber int) (int, error) {
+	return s.CountContext(context.Background(), target, repoIDOrName, prNumber)
+}
+
+// CountContext is like Count but with the context.
+func (s *PullRequestCommentService) CountContext(ctx context.Context, target ProjectIDOrKeyGetter, repoIDOrName string, prNumber int) (int, error) {
+	spath, err := pullRequestPath(target, repoIDOrName, prNumber)
+	if err != nil {
+		return 0, err
+	}
+
+	return countComments(ctx, s.method.Get, spath+"/comments/count")
+}
+
+// Add adds a comment to the pull request.
+//
+// This method supports options returned by methods in "*Client.PullRequest.Comment.Option".
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-pull-request-comment
+func (s *PullRequestCommentService) Add(target ProjectIDOrKeyGetter, repoIDOrName string, prNumber int, content string, options ...CommentOption) (*Comment, error) {
+	return s.AddContext(context.Background(), target, repoIDOrName, prNumber, content, options...)
+}
+
+// AddContext is like Add but with the context.
+func (s *PullRequestCommentService) AddContext(ctx context.Context, target ProjectIDOrKeyGetter, repoIDOrName string, prNumber int, content string, options ...CommentOption) (*Comment, error) {
+	spath, err := pullRequestPath(target, repoIDOrName, prNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return addComment(ctx, s.method.Post, spath+"/comments", content, options...)
+}
+
+// Update updates content of the comment in the pull request.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-pull-request-comment-information
+func (s *PullRequestCommentService) Update(target ProjectIDOrKeyGetter, repoIDOrName string, prNumber, commentID int, content string) (*Comment, error) {
+	return s.UpdateContext(context.Background(), target, repoIDOrName, prNumber, commentID, content)
+}
+
+// UpdateContext is like Update but with the context.
+func (s *PullRequestCommentService) UpdateContext(ctx context.Context, target ProjectIDOrKeyGetter, repoIDOrName string, prNumber, commentID int, content string) (*Comment, error) {
+	if commentID < 1 {
+		return nil, fmt.Errorf("commentID must be 1 or more: %d", commentID)
+	}
+	spath, err := pullRequestPath(target, repoIDOrName, prNumber)
+	if err != nil {
+		return nil, err
+	}
+	spath += "/comments/" + strconv.Itoa(commentID)
+
+	return updateComment(ctx, s.method.Patch, spath, content)
 }
@@ -0,0 +1,543 @@
+package backlog_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func newPullRequestService() *backlog.PullRequestService {
+	return &backlog.PullRequestService{
+		Option: &backlog.PullRequestOptionService{},
+	}
+}
+
+func TestPullRequestService_All(t *testing.T) {
+	bj, err := os.Open("testdata/json/pull_request_list.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := newPullRequestService()
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/git/repositories/app/pullRequests", spath)
+			v := *params.ExportURLValues()
+			assert.Equal(t, []string{"1", "2"}, v["statusId[]"])
+			assert.Equal(t, []string{"3"}, v["assigneeId[]"])
+			assert.Equal(t, []string{"31"}, v["issueId[]"])
+			assert.Equal(t, []string{"4"}, v["createdUserId[]"])
+			assert.Equal(t, "20", params.Get("offset"))
+			assert.Equal(t, "10", params.Get("count"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	o := s.Option
+	prs, err := s.All(
+		backlog.ProjectKey("TEST"), "app",
+		o.WithStatusIDs([]int{1, 2}), o.WithAssigneeIDs([]int{3}), o.WithIssueIDs([]int{31}),
+		o.WithCreatedUserIDs([]int{4}), o.WithOffset(20), o.WithCount(10),
+	)
+	assert.NoError(t, err)
+	if assert.Len(t, prs, 2) {
+		assert.Equal(t, 1, prs[0].Number)
+		assert.Equal(t, "Open", prs[0].Status.Name)
+		assert.Equal(t, "TEST-1", prs[0].Issue.IssueKey)
+		assert.Equal(t, 2, prs[1].Number)
+		assert.Equal(t, "feature/typo", prs[1].Branch)
+		assert.False(t, prs[1].MergeAt.IsZero())
+	}
+}
+
+func TestPullRequestService_All_param_error(t *testing.T) {
+	s := newPullRequestService()
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Get must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	prs, err := s.All(backlog.ProjectKey("TEST"), "")
+	assert.Nil(t, prs)
+	assert.Error(t, err)
+
+	prs, err = s.All(backlog.ProjectKey("TEST"), "app", s.Option.WithCount(0))
+	assert.Nil(t, prs)
+	assert.Error(t, err)
+
+	prs, err = s.All(backlog.ProjectKey("TEST"), "app", s.Option.WithStatusIDs([]int{0}))
+	assert.Nil(t, prs)
+	assert.Error(t, err)
+}
+
+func TestPullRequestService_All_invaliedJson(t *testing.T) {
+	bj, err := os.Open("testdata/json/invalied.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := newPullRequestService()
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+
+	prs, err := s.All(backlog.ProjectKey("TEST"), "app")
+	assert.Nil(t, prs)
+	assert.Error(t, err)
+}
+
+func TestPullRequestService_Count(t *testing.T) {
+	body := ioutil.NopCloser(strings.NewReader(`{"count":10}`))
+	s := newPullRequestService()
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/git/repositories/app/pullRequests/count", spath)
+			v := *params.ExportURLValues()
+			assert.Equal(t, []string{"1"}, v["statusId[]"])
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       body,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	count, err := s.Count(backlog.ProjectKey("TEST"), "app", s.Option.WithStatusIDs([]int{1}))
+	assert.NoError(t, err)
+	assert.Equal(t, 10, count)
+}
+
+func TestPullRequestService_Count_clientError(t *testing.T) {
+	s := newPullRequestService()
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			return nil, errors.New("error")
+		},
+	})
+	count, err := s.Count(backlog.ProjectKey("TEST"), "app")
+	assert.Zero(t, count)
+	assert.Error(t, err)
+}
+
+func TestPullRequestService_One(t *testing.T) {
+	bj, err := os.Open("testdata/json/pull_request.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := newPullRequestService()
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/3/git/repositories/5/pullRequests/1", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	pr, err := s.One(backlog.ProjectID(3), "5", 1)
+	assert.NoError(t, err)
+	if assert.NotNil(t, pr) {
+		assert.Equal(t, 2, pr.ID)
+		assert.Equal(t, 5, pr.RepositoryID)
+		assert.Equal(t, "master", pr.Base)
+		assert.Equal(t, "develop", pr.Branch)
+		assert.Equal(t, "admin", pr.Assignee.Name)
+		assert.True(t, pr.MergeAt.IsZero())
+	}
+}
+
+func TestPullRequestService_One_param_error(t *testing.T) {
+	cases := map[string]struct {
+		target       backlog.ProjectIDOrKeyGetter
+		repoIDOrName string
+		prNumber     int
+	}{
+		"invalied_project": {
+			target:       backlog.ProjectID(0),
+			repoIDOrName: "app",
+			prNumber:     1,
+		},
+		"empty_repository": {
+			target:       backlog.ProjectKey("TEST"),
+			repoIDOrName: "",
+			prNumber:     1,
+		},
+		"prNumber_0": {
+			target:       backlog.ProjectKey("TEST"),
+			repoIDOrName: "app",
+			prNumber:     0,
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			s := newPullRequestService()
+			s.ExportSetMethod(&backlog.ExportMethod{
+				Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+					t.Error("s.method.Get must never be called")
+					return nil, errors.New("error")
+				},
+			})
+
+			pr, err := s.One(tc.target, tc.repoIDOrName, tc.prNumber)
+			assert.Nil(t, pr)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPullRequestService_Create(t *testing.T) {
+	bj, err := os.Open("testdata/json/pull_request.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := newPullRequestService()
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/git/repositories/app/pullRequests", spath)
+			assert.Equal(t, "test", params.Get("summary"))
+			assert.Equal(t, "test data", params.Get("description"))
+			assert.Equal(t, "master", params.Get("base"))
+			assert.Equal(t, "develop", params.Get("branch"))
+			assert.Equal(t, "31", params.Get("issueId"))
+			assert.Equal(t, "1", params.Get("assigneeId"))
+			v := *params.ExportURLValues()
+			assert.Equal(t, []string{"2", "3"}, v["notifiedUserId[]"])
+			assert.Equal(t, []string{"8"}, v["attachmentId[]"])
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	o := s.Option
+	pr, err := s.Create(
+		backlog.ProjectKey("TEST"), "app", "test", "test data", "master", "develop",
+		o.WithIssueID(31), o.WithAssigneeID(1), o.WithNotifiedUserIDs([]int{2, 3}), o.WithAttachmentIDs([]int{8}),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, pr.Number)
+}
+
+func TestPullRequestService_Create_param_error(t *testing.T) {
+	cases := map[string]struct {
+		repoIDOrName string
+		summary      string
+		description  string
+		base         string
+		branch       string
+		options      []backlog.PullRequestOption
+	}{
+		"empty_repository": {
+			repoIDOrName: "",
+			summary:      "test",
+			description:  "test data",
+			base:         "master",
+			branch:       "develop",
+		},
+		"empty_summary": {
+			repoIDOrName: "app",
+			summary:      "",
+			description:  "test data",
+			base:         "master",
+			branch:       "develop",
+		},
+		"empty_description": {
+			repoIDOrName: "app",
+			summary:      "test",
+			description:  "",
+			base:         "master",
+			branch:       "develop",
+		},
+		"empty_base": {
+			repoIDOrName: "app",
+			summary:      "test",
+			description:  "test data",
+			base:         "",
+			branch:       "develop",
+		},
+		"empty_branch": {
+			repoIDOrName: "app",
+			summary:      "test",
+			description:  "test data",
+			base:         "master",
+			branch:       "",
+		},
+		"invalied_option": {
+			repoIDOrName: "app",
+			summary:      "test",
+			description:  "test data",
+			base:         "master",
+			branch:       "develop",
+			options:      []backlog.PullRequestOption{(&backlog.PullRequestOptionService{}).WithIssueID(0)},
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			s := newPullRequestService()
+			s.ExportSetMethod(&backlog.ExportMethod{
+				Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+					t.Error("s.method.Post must never be called")
+					return nil, errors.New("error")
+				},
+			})
+
+			pr, err := s.Create(backlog.ProjectKey("TEST"), tc.repoIDOrName, tc.summary, tc.description, tc.base, tc.branch, tc.options...)
+			assert.Nil(t, pr)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPullRequestService_Update(t *testing.T) {
+	bj, err := os.Open("testdata/json/pull_request.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := newPullRequestService()
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/git/repositories/app/pullRequests/1", spath)
+			assert.Equal(t, "test", params.Get("summary"))
+			assert.Equal(t, "test data", params.Get("description"))
+			assert.Equal(t, "31", params.Get("issueId"))
+			assert.Equal(t, "1", params.Get("assigneeId"))
+			assert.Equal(t, "updated", params.Get("comment"))
+			v := *params.ExportURLValues()
+			assert.Equal(t, []string{"2"}, v["notifiedUserId[]"])
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	o := s.Option
+	pr, err := s.Update(
+		backlog.ProjectKey("TEST"), "app", 1,
+		o.WithSummary("test"), o.WithDescription("test data"), o.WithIssueID(31),
+		o.WithAssigneeID(1), o.WithNotifiedUserIDs([]int{2}), o.WithComment("updated"),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, pr.Number)
+}
+
+func TestPullRequestService_Update_param_error(t *testing.T) {
+	s := newPullRequestService()
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Patch must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	pr, err := s.Update(backlog.ProjectKey("TEST"), "app", 1)
+	assert.Nil(t, pr)
+	assert.Error(t, err)
+
+	pr, err = s.Update(backlog.ProjectKey("TEST"), "app", 0, s.Option.WithSummary("test"))
+	assert.Nil(t, pr)
+	assert.Error(t, err)
+
+	pr, err = s.Update(backlog.ProjectKey("TEST"), "app", 1, s.Option.WithSummary(""))
+	assert.Nil(t, pr)
+	assert.Error(t, err)
+}
+
+func TestPullRequestService_Update_clientError(t *testing.T) {
+	s := newPullRequestService()
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			return nil, errors.New("error")
+		},
+	})
+
+	pr, err := s.Update(backlog.ProjectKey("TEST"), "app", 1, s.Option.WithSummary("test"))
+	assert.Nil(t, pr)
+	assert.Error(t, err)
+}
+
+func TestPullRequestCommentService_List(t *testing.T) {
+	bj, err := os.Open("testdata/json/comment_list.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.PullRequestCommentService{
+		Option: &backlog.CommentOptionService{},
+	}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/git/repositories/app/pullRequests/1/comments", spath)
+			assert.Equal(t, "5", params.Get("count"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	comments, err := s.List(backlog.ProjectKey("TEST"), "app", 1, s.Option.WithCount(5))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, comments)
+}
+
+func TestPullRequestCommentService_List_param_error(t *testing.T) {
+	s := &backlog.PullRequestCommentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Get must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	comments, err := s.List(backlog.ProjectKey("TEST"), "app", 0)
+	assert.Nil(t, comments)
+	assert.Error(t, err)
+}
+
+func TestPullRequestCommentService_Count(t *testing.T) {
+	body := ioutil.NopCloser(strings.NewReader(`{"count":3}`))
+	s := &backlog.PullRequestCommentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/git/repositories/app/pullRequests/1/comments/count", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       body,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	count, err := s.Count(backlog.ProjectKey("TEST"), "app", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+}
+
+func TestPullRequestCommentService_Add(t *testing.T) {
+	bj, err := os.Open("testdata/json/comment.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.PullRequestCommentService{
+		Option: &backlog.CommentOptionService{},
+	}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/git/repositories/app/pullRequests/1/comments", spath)
+			assert.Equal(t, "test", params.Get("content"))
+			v := *params.ExportURLValues()
+			assert.Equal(t, []string{"2"}, v["notifiedUserId[]"])
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	comment, err := s.Add(backlog.ProjectKey("TEST"), "app", 1, "test", s.Option.WithNotifiedUserIDs([]int{2}))
+	assert.NoError(t, err)
+	assert.Equal(t, 6, comment.ID)
+}
+
+func TestPullRequestCommentService_Add_param_error(t *testing.T) {
+	s := &backlog.PullRequestCommentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Post must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	comment, err := s.Add(backlog.ProjectKey("TEST"), "app", 1, "")
+	assert.Nil(t, comment)
+	assert.Error(t, err)
+
+	comment, err = s.Add(backlog.ProjectKey("TEST"), "", 1, "test")
+	assert.Nil(t, comment)
+	assert.Error(t, err)
+}
+
+func TestPullRequestCommentService_Update(t *testing.T) {
+	bj, err := os.Open("testdata/json/comment.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.PullRequestCommentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/git/repositories/app/pullRequests/1/comments/6", spath)
+			assert.Equal(t, "test", params.Get("content"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	comment, err := s.Update(backlog.ProjectKey("TEST"), "app", 1, 6, "test")
+	assert.NoError(t, err)
+	assert.Equal(t, 6, comment.ID)
+}
+
+func TestPullRequestCommentService_Update_param_error(t *testing.T) {
+	s := &backlog.PullRequestCommentService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Patch must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	comment, err := s.Update(backlog.ProjectKey("TEST"), "app", 1, 0, "test")
+	assert.Nil(t, comment)
+	assert.Error(t, err)
+
+	comment, err = s.Update(backlog.ProjectKey("TEST"), "app", 1, 6, "")
+	assert.Nil(t, comment)
+	assert.Error(t, err)
+}
@@ -0,0 +1,343 @@
+package backlog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// dateRange is a pair of bounds of date condition.
+type dateRange struct {
+	since Date
+	until Date
+}
+
+// projectIDResolver resolves project key to ID.
+type projectIDResolver func(ctx context.Context, key ProjectKey) (int, error)
+
+// IssueQuery is a builder of conditions to search issues.
+// The same query can be passed to both (*IssueService).AllByQuery and (*IssueService).CountByQuery.
+//
+//	q := c.Issue.Query().
+//		Project(backlog.ProjectKey("TEST")).
+//		Status(backlog.StatusOpen, backlog.StatusInProgress).
+//		AssignedTo(myself.ID).
+//		DueBefore(backlog.DateOf(time.Now())).
+//		Keyword("crash").
+//		SortBy(backlog.SortUpdated, backlog.OrderDesc)
+//	issues, err := c.Issue.AllByQuery(q)
+//
+// Project keys are resolved once and the IDs are reused while the query is sent again.
+type IssueQuery struct {
+	projects    []ProjectIDOrKeyGetter
+	resolved    map[ProjectKey]int
+	idLists     map[string][]int
+	parentChild *parentChild
+	attachment  *bool
+	sharedFile  *bool
+	keyword     string
+	dateRanges  map[string]*dateRange
+	sort        issueSort
+	order       order
+	offset      *int
+	count       *int
+}
+
+func newIssueQuery() *IssueQuery {
+	return &IssueQuery{
+		resolved:   map[ProjectKey]int{},
+		idLists:    map[string][]int{},
+		dateRanges: map[string]*dateRange{},
+	}
+}
+
+func (q *IssueQuery) addIDs(key string, ids []int) *IssueQuery {
+	q.idLists[key] = append(q.idLists[key], ids...)
+	return q
+}
+
+func (q *IssueQuery) dateRange(key string) *dateRange {
+	r, ok := q.dateRanges[key]
+	if !ok {
+		r = &dateRange{}
+		q.dateRanges[key] = r
+	}
+	return r
+}
+
+// Project narrows issues to the projects.
+// Project keys are resolved to IDs when the query is sent.
+func (q *IssueQuery) Project(targets ...ProjectIDOrKeyGetter) *IssueQuery {
+	q.projects = append(q.projects, targets...)
+	return q
+}
+
+// IssueType narrows issues to the issue types.
+func (q *IssueQuery) IssueType(ids ...int) *IssueQuery {
+	return q.addIDs("issueTypeId", ids)
+}
+
+// Category narrows issues to the categories.
+func (q *IssueQuery) Category(ids ...int) *IssueQuery {
+	return q.addIDs("categoryId", ids)
+}
+
+// Version narrows issues to the versions.
+func (q *IssueQuery) Version(ids ...int) *IssueQuery {
+	return q.addIDs("versionId", ids)
+}
+
+// Milestone narrows issues to the milestones.
+func (q *IssueQuery) Milestone(ids ...int) *IssueQuery {
+	return q.addIDs("milestoneId", ids)
+}
+
+// Status narrows issues to the statuses.
+func (q *IssueQuery) Status(ids ...int) *IssueQuery {
+	return q.addIDs("statusId", ids)
+}
+
+// Priority narrows issues to the priorities.
+func (q *IssueQuery) Priority(ids ...int) *IssueQuery {
+	return q.addIDs("priorityId", ids)
+}
+
+// AssignedTo narrows issues to ones assigned to the users.
+func (q *IssueQuery) AssignedTo(userIDs ...int) *IssueQuery {
+	return q.addIDs("assigneeId", userIDs)
+}
+
+// CreatedBy narrows issues to ones created by the users.
+func (q *IssueQuery) CreatedBy(userIDs ...int) *IssueQuery {
+	return q.addIDs("createdUserId", userIDs)
+}
+
+// Resolution narrows issues to the resolutions.
+func (q *IssueQuery) Resolution(ids ...int) *IssueQuery {
+	return q.addIDs("resolutionId", ids)
+}
+
+// ID narrows issues to the IDs.
+func (q *IssueQuery) ID(ids ...int) *IssueQuery {
+	return q.addIDs("id", ids)
+}
+
+// ParentIssue narrows issues to children of the issues.
+func (q *IssueQuery) ParentIssue(ids ...int) *IssueQuery {
+	return q.addIDs("parentIssueId", ids)
+}
+
+// ParentChild narrows issues by the parent-child relationship.
+func (q *IssueQuery) ParentChild(parentChild parentChild) *IssueQuery {
+	q.parentChild = &parentChild
+	return q
+}
+
+// HasAttachment narrows issues by whether they have attachments.
+func (q *IssueQuery) HasAttachment(enabeld bool) *IssueQuery {
+	q.attachment = &enabeld
+	return q
+}
+
+// HasSharedFile narrows issues by whether they have shared files.
+func (q *IssueQuery) HasSharedFile(enabeld bool) *IssueQuery {
+	q.sharedFile = &enabeld
+	return q
+}
+
+// Keyword narrows issues by the keyword.
+func (q *IssueQuery) Keyword(keyword string) *IssueQuery {
+	q.keyword = keyword
+	return q
+}
+
+// CreatedAfter narrows issues to ones created on or after the date.
+func (q *IssueQuery) CreatedAfter(date Date) *IssueQuery {
+	q.dateRange("created").since = date
+	return q
+}
+
+// CreatedBefore narrows issues to ones created on or before the date.
+func (q *IssueQuery) CreatedBefore(date Date) *IssueQuery {
+	q.dateRange("created").until = date
+	return q
+}
+
+// UpdatedAfter narrows issues to ones updated on or after the date.
+func (q *IssueQuery) UpdatedAfter(date Date) *IssueQuery {
+	q.dateRange("updated").since = date
+	return q
+}
+
+// UpdatedBefore narrows issues to ones updated on or before the date.
+func (q *IssueQuery) UpdatedBefore(date Date) *IssueQuery {
+	q.dateRange("updated").until = date
+	return q
+}
+
+// StartAfter narrows issues to ones starting on or after the date.
+func (q *IssueQuery) StartAfter(date Date) *IssueQuery {
+	q.dateRange("startDate").since = date
+	return q
+}
+
+// StartBefore narrows issues to ones starting on or before the date.
+func (q *IssueQuery) StartBefore(date Date) *IssueQuery {
+	q.dateRange("startDate").until = date
+	return q
+}
+
+// DueAfter narrows issues to ones due on or after the date.
+func (q *IssueQuery) DueAfter(date Date) *IssueQuery {
+	q.dateRange("dueDate").since = date
+	return q
+}
+
+// DueBefore narrows issues to ones due on or before the date.
+func (q *IssueQuery) DueBefore(date Date) *IssueQuery {
+	q.dateRange("dueDate").until = date
+	return q
+}
+
+// SortBy sorts issues by the key in the order.
+// It is ignored by (*IssueService).CountByQuery.
+func (q *IssueQuery) SortBy(sort issueSort, order order) *IssueQuery {
+	q.sort = sort
+	q.order = order
+	return q
+}
+
+// Offset skips the number of issues.
+// It is ignored by (*IssueService).CountByQuery.
+func (q *IssueQuery) Offset(offset int) *IssueQuery {
+	q.offset = &offset
+	return q
+}
+
+// Limit sets the maximum number of issues to return.
+// It must be between 1 and 100.
+// It is ignored by (*IssueService).CountByQuery.
+func (q *IssueQuery) Limit(count int) *IssueQuery {
+	q.count = &count
+	return q
+}
+
+// validate checks combinations of the conditions.
+func (q *IssueQuery) validate() error {
+	for _, target := range q.projects {
+		switch t := target.(type) {
+		case nil:
+			return errors.New("project must not be nil")
+		case ProjectID:
+			if t < 1 {
+				return fmt.Errorf("projectID must be 1 or more: %d", t)
+			}
+		case ProjectKey:
+			if t == "" {
+				return errors.New("key must not be empty")
+			}
+		}
+	}
+	for key, r := range q.dateRanges {
+		if !r.since.IsZero() && !r.until.IsZero() && r.since.After(r.until) {
+			return fmt.Errorf("%sSince must not be after %sUntil", key, key)
+		}
+	}
+	if len(q.idLists["parentIssueId"]) > 0 && q.parentChild != nil {
+		if *q.parentChild != ParentChildAll && *q.parentChild != ParentChildChild {
+			return fmt.Errorf("parentIssueId can not be used with parentChild '%s'", *q.parentChild)
+		}
+	}
+	if (q.sort == "") != (q.order == "") {
+		return errors.New("sort and order must be set together")
+	}
+	return nil
+}
+
+// params compiles the query into request parameters.
+// Sorting and paging are omitted when forCount is true.
+func (q *IssueQuery) params(ctx context.Context, resolve projectIDResolver, forCount bool) (*requestParams, error) {
+	if q == nil {
+		return nil, errors.New("query must not be nil")
+	}
+	if err := q.validate(); err != nil {
+		return nil, err
+	}
+
+	options := []option{}
+
+	projectIDs := make([]int, 0, len(q.projects))
+	for _, target := range q.projects {
+		var id int
+		switch t := target.(type) {
+		case ProjectID:
+			id = int(t)
+		case ProjectKey:
+			v, ok := q.resolved[t]
+			if !ok {
+				var err error
+				if v, err = resolve(ctx, t); err != nil {
+					return nil, err
+				}
+				q.resolved[t] = v
+			}
+			id = v
+		}
+		projectIDs = append(projectIDs, id)
+	}
+	if len(projectIDs) > 0 {
+		options = append(options, withProjectIDs(projectIDs))
+	}
+
+	keys := make([]string, 0, len(q.idLists))
+	for key := range q.idLists {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		options = append(options, withIDList(key, q.idLists[key]))
+	}
+
+	if q.parentChild != nil {
+		options = append(options, withParentChild(*q.parentChild))
+	}
+	if q.attachment != nil {
+		options = append(options, withAttachment(*q.attachment))
+	}
+	if q.sharedFile != nil {
+		options = append(options, withSharedFile(*q.sharedFile))
+	}
+	if q.keyword != "" {
+		options = append(options, withKeyword(q.keyword))
+	}
+	for key, r := range q.dateRanges {
+		if !r.since.IsZero() {
+			options = append(options, withDate(key+"Since", r.since))
+		}
+		if !r.until.IsZero() {
+			options = append(options, withDate(key+"Until", r.until))
+		}
+	}
+
+	if !forCount {
+		if q.sort != "" {
+			options = append(options, withSort(q.sort), withOrder(q.order))
+		}
+		if q.offset != nil {
+			options = append(options, withOffset(*q.offset))
+		}
+		if q.count != nil {
+			options = append(options, withCount(*q.count))
+		}
+	}
+
+	params := newRequestParams()
+	for _, option := range options {
+		if err := option(params); err != nil {
+			return nil, err
+		}
+	}
+
+	return params, nil
+}
@@ -0,0 +1,205 @@
+package backlog_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueService_AllByQuery(t *testing.T) {
+	due := backlog.NewDate(2020, time.March, 31)
+	calls := []string{}
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			calls = append(calls, spath)
+
+			body := `[]`
+			switch spath {
+			case "projects/TEST":
+				assert.Nil(t, params)
+				body = `{"id":12,"projectKey":"TEST"}`
+			case "issues":
+				v := *params.ExportURLValues()
+				assert.Equal(t, []string{"12", "3"}, v["projectId[]"])
+				assert.Equal(t, []string{"1", "2"}, v["statusId[]"])
+				assert.Equal(t, []string{"5"}, v["assigneeId[]"])
+				assert.Equal(t, "2020-03-31", params.Get("dueDateUntil"))
+				assert.Equal(t, "", params.Get("dueDateSince"))
+				assert.Equal(t, "crash", params.Get("keyword"))
+				assert.Equal(t, "updated", params.Get("sort"))
+				assert.Equal(t, "desc", params.Get("order"))
+				assert.Equal(t, "40", params.Get("offset"))
+				assert.Equal(t, "20", params.Get("count"))
+			}
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+
+	q := s.Query().
+		Project(backlog.ProjectKey("TEST"), backlog.ProjectID(3)).
+		Status(backlog.StatusOpen, backlog.StatusInProgress).
+		AssignedTo(5).
+		DueBefore(due).
+		Keyword("crash").
+		SortBy(backlog.SortUpdated, backlog.OrderDesc).
+		Offset(40).
+		Limit(20)
+	issues, err := s.AllByQuery(q)
+	assert.NoError(t, err)
+	assert.Empty(t, issues)
+	assert.Equal(t, []string{"projects/TEST", "issues"}, calls)
+}
+
+func TestIssueService_CountByQuery(t *testing.T) {
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "issues/count", spath)
+			v := *params.ExportURLValues()
+			assert.Equal(t, []string{"3"}, v["projectId[]"])
+			assert.Equal(t, "2020-01-01", params.Get("createdSince"))
+			assert.Equal(t, "2020-01-31", params.Get("createdUntil"))
+			assert.Equal(t, "true", params.Get("attachment"))
+			assert.Equal(t, "", params.Get("sort"))
+			assert.Equal(t, "", params.Get("order"))
+			assert.Equal(t, "", params.Get("offset"))
+			assert.Equal(t, "", params.Get("count"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"count":7}`)),
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+
+	q := s.Query().
+		Project(backlog.ProjectID(3)).
+		CreatedAfter(backlog.NewDate(2020, time.January, 1)).
+		CreatedBefore(backlog.NewDate(2020, time.January, 31)).
+		HasAttachment(true).
+		SortBy(backlog.SortCreated, backlog.OrderAsc).
+		Offset(10).
+		Limit(10)
+	count, err := s.CountByQuery(q)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, count)
+}
+
+func TestIssueService_AllByQuery_invalid(t *testing.T) {
+	day := backlog.NewDate(2020, time.May, 10)
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Get must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	cases := map[string]*backlog.IssueQuery{
+		"nil":              nil,
+		"reversedRange":    s.Query().DueAfter(backlog.NewDate(2020, time.May, 11)).DueBefore(day),
+		"invalidID":        s.Query().Status(0),
+		"invalidProject":   s.Query().Project(backlog.ProjectID(0)),
+		"negativeProject":  s.Query().Project(backlog.ProjectKey("TEST"), backlog.ProjectID(-1)),
+		"emptyProjectKey":  s.Query().Project(backlog.ProjectKey("")),
+		"parentChild":      s.Query().ParentIssue(1).ParentChild(backlog.ParentChildExcludeChild),
+		"sortWithoutOrder": s.Query().SortBy(backlog.SortUpdated, ""),
+		"invalidSort":      s.Query().SortBy("test", backlog.OrderAsc),
+		"invalidLimit":     s.Query().Limit(101),
+		"zeroLimit":        s.Query().Limit(0),
+		"invalidOffset":    s.Query().Offset(-1),
+	}
+	for n, q := range cases {
+		q := q
+		t.Run(n, func(t *testing.T) {
+			issues, err := s.AllByQuery(q)
+			assert.Nil(t, issues)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestIssueService_AllByQuery_sameDay(t *testing.T) {
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "2020-05-10", params.Get("updatedSince"))
+			assert.Equal(t, "2020-05-10", params.Get("updatedUntil"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(`[]`)),
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+
+	q := s.Query().
+		UpdatedAfter(backlog.DateOf(time.Date(2020, 5, 10, 18, 0, 0, 0, time.UTC))).
+		UpdatedBefore(backlog.DateOf(time.Date(2020, 5, 10, 9, 0, 0, 0, time.UTC)))
+	_, err := s.AllByQuery(q)
+	assert.NoError(t, err)
+}
+
+func TestIssueService_AllByQuery_resolveOnce(t *testing.T) {
+	calls := []string{}
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			calls = append(calls, spath)
+
+			body := `[]`
+			switch spath {
+			case "projects/TEST":
+				body = `{"id":12,"projectKey":"TEST"}`
+			case "issues", "issues/count":
+				v := *params.ExportURLValues()
+				assert.Equal(t, []string{"12", "12"}, v["projectId[]"])
+				if spath == "issues/count" {
+					body = `{"count":0}`
+				}
+			}
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+
+	q := s.Query().Project(backlog.ProjectKey("TEST"), backlog.ProjectKey("TEST"))
+	_, err := s.AllByQuery(q)
+	assert.NoError(t, err)
+	_, err = s.CountByQuery(q)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"projects/TEST", "issues", "issues/count"}, calls)
+}
+
+func TestIssueService_AllByQuery_resolveError(t *testing.T) {
+	s := &backlog.IssueService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST", spath)
+			return nil, errors.New("error")
+		},
+	})
+
+	issues, err := s.AllByQuery(s.Query().Project(backlog.ProjectKey("TEST")))
+	assert.Nil(t, issues)
+	assert.EqualError(t, err, "error")
+}
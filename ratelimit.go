@@ -0,0 +1,134 @@
+package backlog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateLimitService has methods for rate limit of API.
+type RateLimitService struct {
+	method *method
+}
+
+// Get returns rate limits of your API key or access token.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-rate-limit
+func (s *RateLimitService) Get() (*RateLimitStatus, error) {
+	return s.GetContext(context.Background())
+}
+
+// GetContext is like Get but with the context.
+func (s *RateLimitService) GetContext(ctx context.Context) (*RateLimitStatus, error) {
+	resp, err := s.method.Get(ctx, "rateLimit", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := struct {
+		RateLimit *RateLimitStatus `json:"rateLimit"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+	if v.RateLimit == nil {
+		return &RateLimitStatus{}, nil
+	}
+
+	return v.RateLimit, nil
+}
+
+// parseRateLimit returns RateLimit from X-RateLimit-* headers.
+// It returns nil if the headers are missing.
+func parseRateLimit(h http.Header) *RateLimit {
+	limit, err := strconv.Atoi(h.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return nil
+	}
+	remaining, err := strconv.Atoi(h.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return nil
+	}
+	reset, err := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return nil
+	}
+
+	return &RateLimit{
+		Limit:     limit,
+		Remaining: remaining,
+		Reset:     reset,
+	}
+}
+
+// rateLimitBucket returns the rate limit in the status which the request is counted against.
+// Backlog API limits reading, updating, searching issues and getting icons separately.
+func rateLimitBucket(s *RateLimitStatus, method, urlPath string) **RateLimit {
+	if method != http.MethodGet {
+		return &s.Update
+	}
+	switch {
+	case strings.HasSuffix(urlPath, "/icon"), strings.HasSuffix(urlPath, "/image"):
+		return &s.Icon
+	case strings.HasSuffix(urlPath, "/"+apiVersion+"/issues"), strings.HasSuffix(urlPath, "/"+apiVersion+"/issues/count"):
+		return &s.Search
+	default:
+		return &s.Read
+	}
+}
+
+// LastRateLimit returns the rate limits received by the latest response of each type of API.
+// The rate limit of the type is nil until a response with X-RateLimit-* headers is received.
+func (c *Client) LastRateLimit() *RateLimitStatus {
+	c.rateLimitMu.Lock()
+	defer c.rateLimitMu.Unlock()
+
+	return &RateLimitStatus{
+		Read:   copyRateLimit(c.rateLimit.Read),
+		Update: copyRateLimit(c.rateLimit.Update),
+		Search: copyRateLimit(c.rateLimit.Search),
+		Icon:   copyRateLimit(c.rateLimit.Icon),
+	}
+}
+
+func copyRateLimit(r *RateLimit) *RateLimit {
+	if r == nil {
+		return nil
+	}
+	v := *r
+	return &v
+}
+
+func (c *Client) setRateLimit(req *http.Request, r *RateLimit) {
+	if r == nil {
+		return
+	}
+	c.rateLimitMu.Lock()
+	defer c.rateLimitMu.Unlock()
+
+	*rateLimitBucket(&c.rateLimit, req.Method, req.URL.Path) = r
+}
+
+// waitRateLimit waits until the rate limit is reset
+// if the remaining of the latest rate limit for the request is less than or equal to the threshold.
+func (c *Client) waitRateLimit(req *http.Request, now time.Time) error {
+	if c.rateLimitThreshold < 0 {
+		return nil
+	}
+	c.rateLimitMu.Lock()
+	r := *rateLimitBucket(&c.rateLimit, req.Method, req.URL.Path)
+	c.rateLimitMu.Unlock()
+	if r == nil || r.Remaining > c.rateLimitThreshold {
+		return nil
+	}
+
+	d := r.ResetTime().Sub(now)
+	if d <= 0 {
+		return nil
+	}
+	return c.sleep(req.Context(), d)
+}
@@ -0,0 +1,270 @@
+package backlog_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitService_Get(t *testing.T) {
+	bj, err := os.Open("testdata/json/rate_limit.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.RateLimitService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "rateLimit", spath)
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+
+	status, err := s.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, 600, status.Read.Limit)
+	assert.Equal(t, 599, status.Read.Remaining)
+	assert.Equal(t, int64(1603881873), status.Read.Reset)
+	assert.Equal(t, 150, status.Update.Limit)
+	assert.Equal(t, 150, status.Search.Limit)
+	assert.Equal(t, 60, status.Icon.Limit)
+}
+
+func TestRateLimitService_Get_clientError(t *testing.T) {
+	s := &backlog.RateLimitService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			return nil, errors.New("error")
+		},
+	})
+
+	status, err := s.Get()
+	assert.Error(t, err)
+	assert.Nil(t, status)
+}
+
+func TestRateLimit_ResetTime(t *testing.T) {
+	r := &backlog.RateLimit{Reset: 1603881873}
+	assert.Equal(t, time.Unix(1603881873, 0), r.ResetTime())
+}
+
+func newRateLimitHeader(limit, remaining int, reset time.Time) http.Header {
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", strconv.Itoa(limit))
+	header.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	header.Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+	return header
+}
+
+func TestClient_LastRateLimit(t *testing.T) {
+	reset := time.Now().Add(time.Minute)
+	c := NewClientMock("https://test.backlog.com", "test", func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     newRateLimitHeader(600, 598, reset),
+		}, nil
+	})
+	assert.Equal(t, &backlog.RateLimitStatus{}, c.LastRateLimit())
+
+	res, err := backlog.ExportClientGet(c, context.Background(), "spath", nil)
+	assert.NoError(t, err)
+
+	want := &backlog.RateLimit{Limit: 600, Remaining: 598, Reset: reset.Unix()}
+	assert.Equal(t, &backlog.RateLimitStatus{Read: want}, c.LastRateLimit())
+	assert.Equal(t, want, res.RateLimit)
+}
+
+func TestClient_LastRateLimit_bucket(t *testing.T) {
+	reset := time.Now().Add(time.Minute)
+	cases := map[string]struct {
+		call  func(c *backlog.Client, spath string) error
+		spath string
+		want  func(s *backlog.RateLimitStatus) *backlog.RateLimit
+	}{
+		"read": {
+			call: func(c *backlog.Client, spath string) error {
+				_, err := backlog.ExportClientGet(c, context.Background(), spath, nil)
+				return err
+			},
+			spath: "projects/TEST",
+			want:  func(s *backlog.RateLimitStatus) *backlog.RateLimit { return s.Read },
+		},
+		"update": {
+			call: func(c *backlog.Client, spath string) error {
+				_, err := backlog.ExportClientPost(c, context.Background(), spath, nil)
+				return err
+			},
+			spath: "issues",
+			want:  func(s *backlog.RateLimitStatus) *backlog.RateLimit { return s.Update },
+		},
+		"search": {
+			call: func(c *backlog.Client, spath string) error {
+				_, err := backlog.ExportClientGet(c, context.Background(), spath, nil)
+				return err
+			},
+			spath: "issues",
+			want:  func(s *backlog.RateLimitStatus) *backlog.RateLimit { return s.Search },
+		},
+		"search-count": {
+			call: func(c *backlog.Client, spath string) error {
+				_, err := backlog.ExportClientGet(c, context.Background(), spath, nil)
+				return err
+			},
+			spath: "issues/count",
+			want:  func(s *backlog.RateLimitStatus) *backlog.RateLimit { return s.Search },
+		},
+		"icon": {
+			call: func(c *backlog.Client, spath string) error {
+				_, err := backlog.ExportClientDownload(c, context.Background(), spath, nil)
+				return err
+			},
+			spath: "users/1/icon",
+			want:  func(s *backlog.RateLimitStatus) *backlog.RateLimit { return s.Icon },
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			c := NewClientMock("https://test.backlog.com", "test", func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     newRateLimitHeader(600, 598, reset),
+				}, nil
+			})
+			assert.NoError(t, tc.call(c, tc.spath))
+
+			s := c.LastRateLimit()
+			assert.Equal(t, &backlog.RateLimit{Limit: 600, Remaining: 598, Reset: reset.Unix()}, tc.want(s))
+			count := 0
+			for _, r := range []*backlog.RateLimit{s.Read, s.Update, s.Search, s.Icon} {
+				if r != nil {
+					count++
+				}
+			}
+			assert.Equal(t, 1, count)
+		})
+	}
+}
+
+func TestClient_LastRateLimit_missingHeader(t *testing.T) {
+	c := NewClientMock("https://test.backlog.com", "test", func(req *http.Request) (*http.Response, error) {
+		header := http.Header{}
+		header.Set("X-RateLimit-Limit", "600")
+		return &http.Response{StatusCode: http.StatusOK, Header: header}, nil
+	})
+
+	res, err := backlog.ExportClientGet(c, context.Background(), "spath", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &backlog.RateLimitStatus{}, c.LastRateLimit())
+	assert.Nil(t, res.RateLimit)
+}
+
+func TestWithRateLimitWait(t *testing.T) {
+	c, err := backlog.NewClient("https://test.backlog.com", "test", backlog.WithRateLimitWait(-1))
+	assert.Error(t, err)
+	assert.Nil(t, c)
+}
+
+func TestClient_Do_rateLimitWait(t *testing.T) {
+	reset := time.Now().Add(time.Hour)
+	cases := map[string]struct {
+		remaining int
+		wantWait  bool
+	}{
+		"above-threshold": {
+			remaining: 11,
+			wantWait:  false,
+		},
+		"threshold": {
+			remaining: 10,
+			wantWait:  true,
+		},
+		"exhausted": {
+			remaining: 0,
+			wantWait:  true,
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			c, _ := backlog.NewClient("https://test.backlog.com", "test", backlog.WithRateLimitWait(10))
+			c.ExportSetHTTPClient(NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     newRateLimitHeader(600, tc.remaining, reset),
+				}, nil
+			}))
+			waits := []time.Duration{}
+			c.ExportSetSleep(func(ctx context.Context, d time.Duration) error {
+				waits = append(waits, d)
+				return nil
+			})
+
+			backlog.ExportClientGet(c, context.Background(), "spath", nil)
+			assert.Empty(t, waits)
+			backlog.ExportClientGet(c, context.Background(), "spath", nil)
+
+			if tc.wantWait {
+				assert.Len(t, waits, 1)
+				assert.True(t, waits[0] > 59*time.Minute)
+			} else {
+				assert.Empty(t, waits)
+			}
+		})
+	}
+}
+
+func TestClient_Do_rateLimitWaitOtherBucket(t *testing.T) {
+	reset := time.Now().Add(time.Hour)
+	c, _ := backlog.NewClient("https://test.backlog.com", "test", backlog.WithRateLimitWait(10))
+	c.ExportSetHTTPClient(NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     newRateLimitHeader(600, 0, reset),
+		}, nil
+	}))
+	waits := []time.Duration{}
+	c.ExportSetSleep(func(ctx context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	})
+
+	// The exhausted read limit does not hold back updates.
+	backlog.ExportClientGet(c, context.Background(), "spath", nil)
+	backlog.ExportClientPost(c, context.Background(), "spath", nil)
+	assert.Empty(t, waits)
+
+	backlog.ExportClientGet(c, context.Background(), "spath", nil)
+	assert.Len(t, waits, 1)
+}
+
+func TestClient_Do_rateLimitWaitCanceled(t *testing.T) {
+	reset := time.Now().Add(time.Hour)
+	c, _ := backlog.NewClient("https://test.backlog.com", "test", backlog.WithRateLimitWait(0))
+	c.ExportSetHTTPClient(NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     newRateLimitHeader(600, 0, reset),
+		}, nil
+	}))
+	backlog.ExportClientGet(c, context.Background(), "spath", nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := backlog.ExportClientGet(c, ctx, "spath", nil)
+	assert.Equal(t, context.Canceled, err)
+}
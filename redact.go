@@ -0,0 +1,66 @@
+package backlog
+
+import (
+	"net/url"
+	"strings"
+)
+
+const redacted = "REDACTED"
+
+// redactedError is an error whose message has no credential.
+// It never unwraps the original error not to expose the credential.
+type redactedError struct {
+	msg string
+}
+
+func (e *redactedError) Error() string {
+	return e.msg
+}
+
+// redactURL returns the URL whose apiKey is replaced.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	q := u.Query()
+	if q.Get("apiKey") == "" {
+		return rawURL
+	}
+	q.Set("apiKey", redacted)
+	u.RawQuery = q.Encode()
+
+	return u.String()
+}
+
+// redact hides the credential of the client in the error.
+func (c *Client) redact(err error) error {
+	if err == nil {
+		return nil
+	}
+	if ue, ok := err.(*url.Error); ok {
+		err = &url.Error{
+			Op:  ue.Op,
+			URL: redactURL(ue.URL),
+			Err: c.redact(ue.Err),
+		}
+	}
+	if c.token != "" && strings.Contains(err.Error(), c.token) {
+		return &redactedError{msg: strings.Replace(err.Error(), c.token, redacted, -1)}
+	}
+	return err
+}
+
+// String returns the description of the client without the credential.
+func (c *Client) String() string {
+	auth := "apiKey"
+	if c.tokenSource != nil {
+		auth = "oauth2"
+	}
+	return "backlog.Client{url: " + c.url.String() + ", auth: " + auth + "}"
+}
+
+// GoString returns the same as String not to print the credential with %#v.
+func (c *Client) GoString() string {
+	return c.String()
+}
@@ -1,6 +1,34 @@
 package backlog
 
+import (
+	"context"
+	"encoding/json"
+)
+
 // ResolutionService has methods for Resolution.
 type ResolutionService struct {
 	method *method
 }
+
+// All returns a list of resolutions.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-resolution-list
+func (s *ResolutionService) All() ([]*Resolution, error) {
+	return s.AllContext(context.Background())
+}
+
+// AllContext is like All but with the context.
+func (s *ResolutionService) AllContext(ctx context.Context) ([]*Resolution, error) {
+	resp, err := s.method.Get(ctx, "resolutions", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := []*Resolution{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
@@ -0,0 +1,127 @@
+package backlog
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy represents a policy to retry requests
+// when Backlog API responds 429 Too Many Requests or 5xx server errors.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts including the first request.
+	MaxAttempts int
+	// MinBackoff is the base duration of waiting before the first retry.
+	MinBackoff time.Duration
+	// MaxBackoff is the upper limit of waiting, including the waiting requested by the response headers.
+	// Zero means no limit.
+	MaxBackoff time.Duration
+	// RetryPost enables retrying non-idempotent requests (POST and PATCH).
+	RetryPost bool
+}
+
+// DefaultRetryPolicy returns a new RetryPolicy with default settings.
+// It retries idempotent requests up to 3 attempts.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+	}
+}
+
+// shouldRetry reports whether the request should be sent again.
+func (p *RetryPolicy) shouldRetry(req *http.Request, resp *http.Response, attempt int) bool {
+	if p == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+	if sc := resp.StatusCode; sc != http.StatusTooManyRequests && sc < 500 {
+		return false
+	}
+	if !isIdempotent(req.Method) && !p.RetryPost {
+		return false
+	}
+	// The body which can not be rewound is never sent again.
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// backoff returns the duration to wait before the next attempt.
+// Retry-After and X-RateLimit-Reset headers take precedence over exponential backoff.
+func (p *RetryPolicy) backoff(resp *http.Response, attempt int, now time.Time) time.Duration {
+	if d, ok := retryAfter(resp, now); ok {
+		if p.MaxBackoff > 0 && d > p.MaxBackoff {
+			return p.MaxBackoff
+		}
+		return d
+	}
+
+	d := p.MinBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+
+	// Equal jitter keeps at least half of the backoff.
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+// retryAfter parses Retry-After or X-RateLimit-Reset header.
+// X-RateLimit-Reset is used only for 429 Too Many Requests,
+// because it is sent with every response and tells nothing about server errors.
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	h := resp.Header
+	if v := h.Get("Retry-After"); v != "" {
+		if sec, err := strconv.Atoi(v); err == nil && sec >= 0 {
+			return time.Duration(sec) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return nonNegative(t.Sub(now)), true
+		}
+	}
+	if v := h.Get("X-RateLimit-Reset"); v != "" && resp.StatusCode == http.StatusTooManyRequests {
+		if sec, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return nonNegative(time.Unix(sec, 0).Sub(now)), true
+		}
+	}
+	return 0, false
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// sleep waits for the duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
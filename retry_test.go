@@ -0,0 +1,352 @@
+package backlog_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithRetryPolicy(t *testing.T) {
+	cases := map[string]struct {
+		policy    *backlog.RetryPolicy
+		wantError bool
+	}{
+		"default": {
+			policy:    backlog.DefaultRetryPolicy(),
+			wantError: false,
+		},
+		"nil": {
+			policy:    nil,
+			wantError: true,
+		},
+		"max-attempts-zero": {
+			policy:    &backlog.RetryPolicy{MaxAttempts: 0},
+			wantError: true,
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			c, err := backlog.NewClient("https://test.backlog.com", "test", backlog.WithRetryPolicy(tc.policy))
+
+			if tc.wantError {
+				assert.Error(t, err)
+				assert.Nil(t, c)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.policy, c.ExportRetryPolicy())
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_shouldRetry(t *testing.T) {
+	p := &backlog.RetryPolicy{MaxAttempts: 3}
+	postOK := &backlog.RetryPolicy{MaxAttempts: 3, RetryPost: true}
+
+	newReq := func(method string) *http.Request {
+		req, _ := http.NewRequest(method, "https://test.backlog.com", bytes.NewReader([]byte("body")))
+		return req
+	}
+	unrewindable, _ := http.NewRequest(http.MethodGet, "https://test.backlog.com", ioutil.NopCloser(bytes.NewReader(nil)))
+
+	cases := map[string]struct {
+		policy     *backlog.RetryPolicy
+		req        *http.Request
+		statusCode int
+		attempt    int
+		want       bool
+	}{
+		"nil-policy": {
+			policy:     nil,
+			req:        newReq(http.MethodGet),
+			statusCode: http.StatusServiceUnavailable,
+			attempt:    1,
+			want:       false,
+		},
+		"get-429": {
+			policy:     p,
+			req:        newReq(http.MethodGet),
+			statusCode: http.StatusTooManyRequests,
+			attempt:    1,
+			want:       true,
+		},
+		"get-500": {
+			policy:     p,
+			req:        newReq(http.MethodGet),
+			statusCode: http.StatusInternalServerError,
+			attempt:    2,
+			want:       true,
+		},
+		"get-404": {
+			policy:     p,
+			req:        newReq(http.MethodGet),
+			statusCode: http.StatusNotFound,
+			attempt:    1,
+			want:       false,
+		},
+		"get-max-attempts": {
+			policy:     p,
+			req:        newReq(http.MethodGet),
+			statusCode: http.StatusServiceUnavailable,
+			attempt:    3,
+			want:       false,
+		},
+		"delete-503": {
+			policy:     p,
+			req:        newReq(http.MethodDelete),
+			statusCode: http.StatusServiceUnavailable,
+			attempt:    1,
+			want:       true,
+		},
+		"post-503": {
+			policy:     p,
+			req:        newReq(http.MethodPost),
+			statusCode: http.StatusServiceUnavailable,
+			attempt:    1,
+			want:       false,
+		},
+		"patch-503": {
+			policy:     p,
+			req:        newReq(http.MethodPatch),
+			statusCode: http.StatusServiceUnavailable,
+			attempt:    1,
+			want:       false,
+		},
+		"post-503-opt-in": {
+			policy:     postOK,
+			req:        newReq(http.MethodPost),
+			statusCode: http.StatusServiceUnavailable,
+			attempt:    1,
+			want:       true,
+		},
+		"unrewindable-body": {
+			policy:     p,
+			req:        unrewindable,
+			statusCode: http.StatusServiceUnavailable,
+			attempt:    1,
+			want:       false,
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			resp := &http.Response{StatusCode: tc.statusCode}
+			got := backlog.ExportRetryPolicyShouldRetry(tc.policy, tc.req, resp, tc.attempt)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := &backlog.RetryPolicy{
+		MaxAttempts: 5,
+		MinBackoff:  time.Second,
+		MaxBackoff:  10 * time.Second,
+	}
+
+	cases := map[string]struct {
+		status  int
+		header  map[string]string
+		attempt int
+		min     time.Duration
+		max     time.Duration
+	}{
+		"first": {
+			attempt: 1,
+			min:     500 * time.Millisecond,
+			max:     time.Second,
+		},
+		"second": {
+			attempt: 2,
+			min:     time.Second,
+			max:     2 * time.Second,
+		},
+		"capped": {
+			attempt: 5,
+			min:     5 * time.Second,
+			max:     10 * time.Second,
+		},
+		"retry-after-seconds": {
+			header:  map[string]string{"Retry-After": "7"},
+			attempt: 1,
+			min:     7 * time.Second,
+			max:     7 * time.Second,
+		},
+		"retry-after-date": {
+			header:  map[string]string{"Retry-After": now.Add(3 * time.Second).Format(http.TimeFormat)},
+			attempt: 1,
+			min:     3 * time.Second,
+			max:     3 * time.Second,
+		},
+		"retry-after-capped": {
+			header:  map[string]string{"Retry-After": "3600"},
+			attempt: 1,
+			min:     10 * time.Second,
+			max:     10 * time.Second,
+		},
+		"ratelimit-reset": {
+			header:  map[string]string{"X-RateLimit-Reset": strconv.FormatInt(now.Add(5*time.Second).Unix(), 10)},
+			attempt: 1,
+			min:     5 * time.Second,
+			max:     5 * time.Second,
+		},
+		"ratelimit-reset-capped": {
+			header:  map[string]string{"X-RateLimit-Reset": strconv.FormatInt(now.Add(60*time.Second).Unix(), 10)},
+			attempt: 1,
+			min:     10 * time.Second,
+			max:     10 * time.Second,
+		},
+		"ratelimit-reset-past": {
+			header:  map[string]string{"X-RateLimit-Reset": strconv.FormatInt(now.Add(-60*time.Second).Unix(), 10)},
+			attempt: 1,
+			min:     0,
+			max:     0,
+		},
+		"ratelimit-reset-server-error": {
+			status:  http.StatusServiceUnavailable,
+			header:  map[string]string{"X-RateLimit-Reset": strconv.FormatInt(now.Add(5*time.Second).Unix(), 10)},
+			attempt: 1,
+			min:     500 * time.Millisecond,
+			max:     time.Second,
+		},
+		"retry-after-server-error": {
+			status:  http.StatusServiceUnavailable,
+			header:  map[string]string{"Retry-After": "7"},
+			attempt: 1,
+			min:     7 * time.Second,
+			max:     7 * time.Second,
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			header := http.Header{}
+			for k, v := range tc.header {
+				header.Set(k, v)
+			}
+			status := tc.status
+			if status == 0 {
+				status = http.StatusTooManyRequests
+			}
+			resp := &http.Response{StatusCode: status, Header: header}
+			got := backlog.ExportRetryPolicyBackoff(p, resp, tc.attempt, now)
+			assert.True(t, tc.min <= got && got <= tc.max, "got %s", got)
+		})
+	}
+}
+
+func TestSleep_canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := backlog.ExportSleep(ctx, time.Hour)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestClient_Do_retry(t *testing.T) {
+	c, _ := backlog.NewClient("https://test.backlog.com", "test", backlog.WithRetryPolicy(backlog.DefaultRetryPolicy()))
+
+	waits := []time.Duration{}
+	c.ExportSetSleep(func(ctx context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	})
+
+	calls := 0
+	httpClient := NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+		calls++
+		if calls == 1 {
+			header := http.Header{}
+			header.Set("Retry-After", "2")
+			return &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     header,
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"errors":[{"message":"Too many requests","code":13}]}`))),
+			}, nil
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewReader([]byte("{}")))}, nil
+	})
+	c.ExportSetHTTPClient(httpClient)
+
+	res, err := backlog.ExportClientGet(c, context.Background(), "spath", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.ExportGetHTTPResponse().StatusCode)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, []time.Duration{2 * time.Second}, waits)
+}
+
+func TestClient_Do_retryExhausted(t *testing.T) {
+	c, _ := backlog.NewClient("https://test.backlog.com", "test", backlog.WithRetryPolicy(backlog.DefaultRetryPolicy()))
+	c.ExportSetSleep(func(ctx context.Context, d time.Duration) error {
+		return nil
+	})
+
+	calls := 0
+	httpClient := NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+		calls++
+		return &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"errors":[{"message":"Internal error","code":1}]}`))),
+		}, nil
+	})
+	c.ExportSetHTTPClient(httpClient)
+
+	_, err := backlog.ExportClientGet(c, context.Background(), "spath", nil)
+	assert.Error(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestClient_Do_retryCanceled(t *testing.T) {
+	c, _ := backlog.NewClient("https://test.backlog.com", "test", backlog.WithRetryPolicy(backlog.DefaultRetryPolicy()))
+
+	httpClient := NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		}, nil
+	})
+	c.ExportSetHTTPClient(httpClient)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := backlog.ExportClientGet(c, ctx, "spath", nil)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestClient_Uploade_retry(t *testing.T) {
+	policy := backlog.DefaultRetryPolicy()
+	policy.RetryPost = true
+	c, _ := backlog.NewClient("https://test.backlog.com", "test", backlog.WithRetryPolicy(policy))
+	c.ExportSetSleep(func(ctx context.Context, d time.Duration) error {
+		return nil
+	})
+
+	bodies := [][]byte{}
+	httpClient := NewHTTPClientMock(func(req *http.Request) (*http.Response, error) {
+		b, _ := ioutil.ReadAll(req.Body)
+		bodies = append(bodies, b)
+		if len(bodies) == 1 {
+			return &http.Response{StatusCode: http.StatusBadGateway, Body: ioutil.NopCloser(bytes.NewReader(nil))}, nil
+		}
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})
+	c.ExportSetHTTPClient(httpClient)
+
+	_, err := backlog.ExportClientUploade(c, context.Background(), "spath", "testdata/testfile", "fname")
+	assert.NoError(t, err)
+	assert.Len(t, bodies, 2)
+	assert.NotEmpty(t, bodies[0])
+	assert.Equal(t, bodies[0], bodies[1])
+}
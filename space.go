@@ -1,5 +1,21 @@
 package backlog
 
+import (
+	"context"
+	"encoding/json"
+)
+
+func decodeSpaceNotification(resp *response) (*SpaceNotification, error) {
+	defer resp.Body.Close()
+
+	v := SpaceNotification{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
 // SpaceService has methods for Space.
 type SpaceService struct {
 	method *method
@@ -7,3 +23,129 @@ type SpaceService struct {
 	Activity   *SpaceActivityService
 	Attachment *SpaceAttachmentService
 }
+
+// Info returns information about your space.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-space
+func (s *SpaceService) Info() (*Space, error) {
+	return s.InfoContext(context.Background())
+}
+
+// InfoContext is like Info but with the context.
+func (s *SpaceService) InfoContext(ctx context.Context) (*Space, error) {
+	resp, err := s.method.Get(ctx, "space", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := Space{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+// Image downloads the logo image of your space.
+// The returned file must be closed after use.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-space-logo
+func (s *SpaceService) Image() (*File, error) {
+	return s.ImageContext(context.Background())
+}
+
+// ImageContext is like Image but with the context.
+// The context is also used while reading the returned file.
+func (s *SpaceService) ImageContext(ctx context.Context) (*File, error) {
+	resp, err := s.method.Download(ctx, "space/image", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return newFile(resp), nil
+}
+
+// Notification returns the notification of your space.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-space-notification
+func (s *SpaceService) Notification() (*SpaceNotification, error) {
+	return s.NotificationContext(context.Background())
+}
+
+// NotificationContext is like Notification but with the context.
+func (s *SpaceService) NotificationContext(ctx context.Context) (*SpaceNotification, error) {
+	resp, err := s.method.Get(ctx, "space/notification", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeSpaceNotification(resp)
+}
+
+// UpdateNotification updates the notification of your space.
+// The empty content removes the notification.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-space-notification
+func (s *SpaceService) UpdateNotification(content string) (*SpaceNotification, error) {
+	return s.UpdateNotificationContext(context.Background(), content)
+}
+
+// UpdateNotificationContext is like UpdateNotification but with the context.
+func (s *SpaceService) UpdateNotificationContext(ctx context.Context, content string) (*SpaceNotification, error) {
+	params := newRequestParams()
+	params.Set("content", content)
+
+	resp, err := s.method.Put(ctx, "space/notification", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeSpaceNotification(resp)
+}
+
+// DiskUsage returns the disk usage of your space and each project.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-space-disk-usage
+func (s *SpaceService) DiskUsage() (*DiskUsageSpace, error) {
+	return s.DiskUsageContext(context.Background())
+}
+
+// DiskUsageContext is like DiskUsage but with the context.
+func (s *SpaceService) DiskUsageContext(ctx context.Context) (*DiskUsageSpace, error) {
+	resp, err := s.method.Get(ctx, "space/diskUsage", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := DiskUsageSpace{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+// Licence returns the licence of your space.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-licence
+func (s *SpaceService) Licence() (*Licence, error) {
+	return s.LicenceContext(context.Background())
+}
+
+// LicenceContext is like Licence but with the context.
+func (s *SpaceService) LicenceContext(ctx context.Context) (*Licence, error) {
+	resp, err := s.method.Get(ctx, "space/licence", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := Licence{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
@@ -0,0 +1,318 @@
+package backlog_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpaceService_Info(t *testing.T) {
+	bj, err := os.Open("testdata/json/space.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.SpaceService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "space", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	space, err := s.Info()
+	assert.NoError(t, err)
+	if assert.NotNil(t, space) {
+		assert.Equal(t, "nulab", space.SpaceKey)
+		assert.Equal(t, "Asia/Tokyo", space.Timezone)
+		assert.Equal(t, backlog.FormatMarkdown, space.TextFormattingRule)
+	}
+}
+
+func TestSpaceService_Info_clientError(t *testing.T) {
+	s := &backlog.SpaceService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			return nil, errors.New("error")
+		},
+	})
+
+	space, err := s.Info()
+	assert.Nil(t, space)
+	assert.Error(t, err)
+}
+
+func TestSpaceService_Image(t *testing.T) {
+	s := &backlog.SpaceService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Download: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "space/image", spath)
+
+			header := http.Header{}
+			header.Set("Content-Type", "image/png")
+			header.Set("Content-Disposition", `attachment; filename*=UTF-8''logo_%E3%83%AD%E3%82%B4.png`)
+			resp := &http.Response{
+				StatusCode:    http.StatusOK,
+				Header:        header,
+				ContentLength: 5,
+				Body:          ioutil.NopCloser(strings.NewReader("image")),
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	f, err := s.Image()
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer f.Close()
+
+	assert.Equal(t, "logo_ロゴ.png", f.Name)
+	assert.Equal(t, "image/png", f.ContentType)
+	assert.Equal(t, int64(5), f.Size)
+	b, err := ioutil.ReadAll(f)
+	assert.NoError(t, err)
+	assert.Equal(t, "image", string(b))
+}
+
+func TestSpaceService_Image_noDisposition(t *testing.T) {
+	s := &backlog.SpaceService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Download: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			resp := &http.Response{
+				StatusCode:    http.StatusOK,
+				Header:        http.Header{},
+				ContentLength: -1,
+				Body:          ioutil.NopCloser(strings.NewReader("image")),
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	f, err := s.Image()
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer f.Close()
+
+	assert.Empty(t, f.Name)
+	assert.Equal(t, int64(-1), f.Size)
+}
+
+func TestSpaceService_Image_clientError(t *testing.T) {
+	s := &backlog.SpaceService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Download: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			return nil, errors.New("error")
+		},
+	})
+
+	f, err := s.Image()
+	assert.Nil(t, f)
+	assert.Error(t, err)
+}
+
+func TestSpaceService_Notification(t *testing.T) {
+	bj, err := os.Open("testdata/json/space_notification.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.SpaceService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "space/notification", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	notification, err := s.Notification()
+	assert.NoError(t, err)
+	if assert.NotNil(t, notification) {
+		assert.Equal(t, "Notification", notification.Content)
+		assert.Equal(t, time.Date(2013, time.June, 18, 7, 55, 37, 0, time.UTC), notification.Updated)
+	}
+}
+
+func TestSpaceService_Notification_invaliedJson(t *testing.T) {
+	bj, err := os.Open("testdata/json/invalied.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.SpaceService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+
+	notification, err := s.Notification()
+	assert.Nil(t, notification)
+	assert.Error(t, err)
+}
+
+func TestSpaceService_UpdateNotification(t *testing.T) {
+	cases := map[string]struct {
+		content string
+	}{
+		"content": {
+			content: "Notification",
+		},
+		"empty": {
+			content: "",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			bj, err := os.Open("testdata/json/space_notification.json")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer bj.Close()
+
+			s := &backlog.SpaceService{}
+			s.ExportSetMethod(&backlog.ExportMethod{
+				Put: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+					assert.Equal(t, "space/notification", spath)
+					v := *params.ExportURLValues()
+					assert.Equal(t, []string{tc.content}, v["content"])
+
+					resp := &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       bj,
+					}
+					return backlog.ExportNewResponse(resp), nil
+				},
+			})
+
+			notification, err := s.UpdateNotification(tc.content)
+			assert.NoError(t, err)
+			assert.NotNil(t, notification)
+		})
+	}
+}
+
+func TestSpaceService_UpdateNotification_clientError(t *testing.T) {
+	s := &backlog.SpaceService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Put: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			return nil, errors.New("error")
+		},
+	})
+
+	notification, err := s.UpdateNotification("Notification")
+	assert.Nil(t, notification)
+	assert.Error(t, err)
+}
+
+func TestSpaceService_DiskUsage(t *testing.T) {
+	bj, err := os.Open("testdata/json/disk_usage.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.SpaceService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "space/diskUsage", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	usage, err := s.DiskUsage()
+	assert.NoError(t, err)
+	if assert.NotNil(t, usage) {
+		assert.Equal(t, 1073741824, usage.Capacity)
+		assert.Equal(t, 119511, usage.Issue)
+		assert.Equal(t, 48575, usage.Wiki)
+		if assert.Len(t, usage.Details, 1) {
+			assert.Equal(t, 1, usage.Details[0].ProjectID)
+			assert.Equal(t, 11931, usage.Details[0].Issue)
+		}
+	}
+}
+
+func TestSpaceService_DiskUsage_clientError(t *testing.T) {
+	s := &backlog.SpaceService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			return nil, errors.New("error")
+		},
+	})
+
+	usage, err := s.DiskUsage()
+	assert.Nil(t, usage)
+	assert.Error(t, err)
+}
+
+func TestSpaceService_Licence(t *testing.T) {
+	bj, err := os.Open("testdata/json/licence.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.SpaceService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "space/licence", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	licence, err := s.Licence()
+	assert.NoError(t, err)
+	if assert.NotNil(t, licence) {
+		assert.True(t, licence.Active)
+		assert.Equal(t, 46, licence.LicenceTypeID)
+		assert.Equal(t, int64(1073741824), licence.StorageLimit)
+		assert.Equal(t, 10485760, licence.AttachmentLimitPerFile)
+		assert.Equal(t, time.Date(2019, time.February, 23, 15, 0, 0, 0, time.UTC), licence.LimitDate)
+	}
+}
+
+func TestSpaceService_Licence_clientError(t *testing.T) {
+	s := &backlog.SpaceService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			return nil, errors.New("error")
+		},
+	})
+
+	licence, err := s.Licence()
+	assert.Nil(t, licence)
+	assert.Error(t, err)
+}
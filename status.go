@@ -1,6 +1,195 @@
 package backlog
 
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+func decodeStatus(resp *response) (*Status, error) {
+	defer resp.Body.Close()
+
+	v := Status{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+func decodeStatusList(resp *response) ([]*Status, error) {
+	defer resp.Body.Close()
+
+	v := []*Status{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
 // StatusService has methods for Status.
 type StatusService struct {
 	method *method
+
+	Option *StatusOptionService
+}
+
+// All returns a list of statuses in the project.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-status-list-of-project
+func (s *StatusService) All(target ProjectIDOrKeyGetter) ([]*Status, error) {
+	return s.AllContext(context.Background(), target)
+}
+
+// AllContext is like All but with the context.
+func (s *StatusService) AllContext(ctx context.Context, target ProjectIDOrKeyGetter) ([]*Status, error) {
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return nil, err
+	}
+	spath := "projects/" + projectIDOrKey + "/statuses"
+
+	resp, err := s.method.Get(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeStatusList(resp)
+}
+
+// Create creates a new status in the project.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-status
+func (s *StatusService) Create(target ProjectIDOrKeyGetter, name, color string) (*Status, error) {
+	return s.CreateContext(context.Background(), target, name, color)
+}
+
+// CreateContext is like Create but with the context.
+func (s *StatusService) CreateContext(ctx context.Context, target ProjectIDOrKeyGetter, name, color string) (*Status, error) {
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return nil, err
+	}
+	spath := "projects/" + projectIDOrKey + "/statuses"
+
+	params := newRequestParams()
+	for _, option := range []option{withName(name), withStatusColor(color)} {
+		if err := option(params); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.method.Post(ctx, spath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeStatus(resp)
+}
+
+// Update updates the status in the project.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-status
+func (s *StatusService) Update(target ProjectIDOrKeyGetter, statusID int, options ...StatusOption) (*Status, error) {
+	return s.UpdateContext(context.Background(), target, statusID, options...)
+}
+
+// UpdateContext is like Update but with the context.
+func (s *StatusService) UpdateContext(ctx context.Context, target ProjectIDOrKeyGetter, statusID int, options ...StatusOption) (*Status, error) {
+	if statusID < 1 {
+		return nil, fmt.Errorf("statusID must be 1 or more: %d", statusID)
+	}
+	if options == nil {
+		return nil, errors.New("requires one or more options")
+	}
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return nil, err
+	}
+	spath := "projects/" + projectIDOrKey + "/statuses/" + strconv.Itoa(statusID)
+
+	params := newRequestParams()
+	for _, option := range options {
+		if err := option(params); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.method.Patch(ctx, spath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeStatus(resp)
+}
+
+// Delete deletes the status in the project.
+// Issues in the status are changed to the substitute status.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-status
+func (s *StatusService) Delete(target ProjectIDOrKeyGetter, statusID, substituteStatusID int) (*Status, error) {
+	return s.DeleteContext(context.Background(), target, statusID, substituteStatusID)
+}
+
+// DeleteContext is like Delete but with the context.
+func (s *StatusService) DeleteContext(ctx context.Context, target ProjectIDOrKeyGetter, statusID, substituteStatusID int) (*Status, error) {
+	if statusID < 1 {
+		return nil, fmt.Errorf("statusID must be 1 or more: %d", statusID)
+	}
+	if substituteStatusID < 1 {
+		return nil, fmt.Errorf("substituteStatusID must be 1 or more: %d", substituteStatusID)
+	}
+	if statusID == substituteStatusID {
+		return nil, errors.New("substituteStatusID must be different from statusID")
+	}
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return nil, err
+	}
+	spath := "projects/" + projectIDOrKey + "/statuses/" + strconv.Itoa(statusID)
+
+	params := newRequestParams()
+	params.Set("substituteStatusId", strconv.Itoa(substituteStatusID))
+
+	resp, err := s.method.Delete(ctx, spath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeStatus(resp)
+}
+
+// UpdateDisplayOrder sorts statuses in the project by order of the IDs.
+// All of the status IDs in the project are required.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-order-of-status
+func (s *StatusService) UpdateDisplayOrder(target ProjectIDOrKeyGetter, statusIDs []int) ([]*Status, error) {
+	return s.UpdateDisplayOrderContext(context.Background(), target, statusIDs)
+}
+
+// UpdateDisplayOrderContext is like UpdateDisplayOrder but with the context.
+func (s *StatusService) UpdateDisplayOrderContext(ctx context.Context, target ProjectIDOrKeyGetter, statusIDs []int) ([]*Status, error) {
+	if len(statusIDs) == 0 {
+		return nil, errors.New("statusIDs must not be empty")
+	}
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return nil, err
+	}
+	spath := "projects/" + projectIDOrKey + "/statuses/updateDisplayOrder"
+
+	params := newRequestParams()
+	if err := withStatusIDs(statusIDs)(params); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.method.Patch(ctx, spath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeStatusList(resp)
 }
@@ -0,0 +1,293 @@
+package backlog_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusService_All(t *testing.T) {
+	bj, err := os.Open("testdata/json/status_list.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.StatusService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/statuses", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	statuses, err := s.All(backlog.ProjectKey("TEST"))
+	assert.NoError(t, err)
+	assert.Len(t, statuses, 3)
+	assert.Equal(t, "Waiting", statuses[2].Name)
+	assert.Equal(t, "#ea2c00", statuses[2].Color)
+	assert.Equal(t, 3000, statuses[2].DisplayOrder)
+}
+
+func TestStatusService_All_invaliedProject(t *testing.T) {
+	s := &backlog.StatusService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Get must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	statuses, err := s.All(backlog.ProjectID(0))
+	assert.Nil(t, statuses)
+	assert.Error(t, err)
+}
+
+func TestStatusService_Create(t *testing.T) {
+	bj, err := os.Open("testdata/json/status.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.StatusService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/1/statuses", spath)
+			assert.Equal(t, "Waiting", params.Get("name"))
+			assert.Equal(t, "#ea2c00", params.Get("color"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	status, err := s.Create(backlog.ProjectID(1), "Waiting", "#ea2c00")
+	assert.NoError(t, err)
+	assert.Equal(t, 5, status.ID)
+}
+
+func TestStatusService_Create_param_error(t *testing.T) {
+	cases := map[string]struct {
+		name  string
+		color string
+	}{
+		"empty_name": {
+			name:  "",
+			color: "#ea2c00",
+		},
+		"invalied_color": {
+			name:  "Waiting",
+			color: "#ffffff",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			s := &backlog.StatusService{}
+			s.ExportSetMethod(&backlog.ExportMethod{
+				Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+					t.Error("s.method.Post must never be called")
+					return nil, errors.New("error")
+				},
+			})
+
+			status, err := s.Create(backlog.ProjectID(1), tc.name, tc.color)
+			assert.Nil(t, status)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestStatusService_Update(t *testing.T) {
+	bj, err := os.Open("testdata/json/status.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.StatusService{
+		Option: &backlog.StatusOptionService{},
+	}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/statuses/5", spath)
+			assert.Equal(t, "Waiting", params.Get("name"))
+			assert.Equal(t, "#ea2c00", params.Get("color"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	o := s.Option
+	status, err := s.Update(backlog.ProjectKey("TEST"), 5, o.WithName("Waiting"), o.WithColor("#ea2c00"))
+	assert.NoError(t, err)
+	assert.Equal(t, 5, status.ID)
+}
+
+func TestStatusService_Update_param_error(t *testing.T) {
+	s := &backlog.StatusService{
+		Option: &backlog.StatusOptionService{},
+	}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Patch must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	status, err := s.Update(backlog.ProjectKey("TEST"), 0, s.Option.WithName("Waiting"))
+	assert.Nil(t, status)
+	assert.Error(t, err)
+
+	status, err = s.Update(backlog.ProjectKey("TEST"), 5)
+	assert.Nil(t, status)
+	assert.Error(t, err)
+
+	status, err = s.Update(backlog.ProjectKey("TEST"), 5, s.Option.WithColor("red"))
+	assert.Nil(t, status)
+	assert.Error(t, err)
+}
+
+func TestStatusService_Delete(t *testing.T) {
+	bj, err := os.Open("testdata/json/status.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.StatusService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/statuses/5", spath)
+			assert.Equal(t, "1", params.Get("substituteStatusId"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	status, err := s.Delete(backlog.ProjectKey("TEST"), 5, backlog.StatusOpen)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, status.ID)
+}
+
+func TestStatusService_Delete_param_error(t *testing.T) {
+	cases := map[string]struct {
+		statusID           int
+		substituteStatusID int
+	}{
+		"statusID_0": {
+			statusID:           0,
+			substituteStatusID: 1,
+		},
+		"substituteStatusID_0": {
+			statusID:           5,
+			substituteStatusID: 0,
+		},
+		"same_id": {
+			statusID:           5,
+			substituteStatusID: 5,
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			s := &backlog.StatusService{}
+			s.ExportSetMethod(&backlog.ExportMethod{
+				Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+					t.Error("s.method.Delete must never be called")
+					return nil, errors.New("error")
+				},
+			})
+
+			status, err := s.Delete(backlog.ProjectKey("TEST"), tc.statusID, tc.substituteStatusID)
+			assert.Nil(t, status)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestStatusService_UpdateDisplayOrder(t *testing.T) {
+	bj, err := os.Open("testdata/json/status_list.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.StatusService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/statuses/updateDisplayOrder", spath)
+			v := *params.ExportURLValues()
+			assert.Equal(t, []string{"1", "2", "5", "3", "4"}, v["statusId[]"])
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	statuses, err := s.UpdateDisplayOrder(backlog.ProjectKey("TEST"), []int{1, 2, 5, 3, 4})
+	assert.NoError(t, err)
+	assert.Len(t, statuses, 3)
+}
+
+func TestStatusService_UpdateDisplayOrder_param_error(t *testing.T) {
+	s := &backlog.StatusService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Patch must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	statuses, err := s.UpdateDisplayOrder(backlog.ProjectKey("TEST"), nil)
+	assert.Nil(t, statuses)
+	assert.Error(t, err)
+
+	statuses, err = s.UpdateDisplayOrder(backlog.ProjectKey("TEST"), []int{1, 0})
+	assert.Nil(t, statuses)
+	assert.Error(t, err)
+}
+
+func TestStatusService_All_invaliedJson(t *testing.T) {
+	bj, err := os.Open("testdata/json/invalied.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.StatusService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	statuses, err := s.All(backlog.ProjectKey("TEST"))
+	assert.Nil(t, statuses)
+	assert.Error(t, err)
+}
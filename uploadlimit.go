@@ -0,0 +1,137 @@
+package backlog
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// UploadLimitError is returned when a file is rejected before uploading
+// because it can not be uploaded under the licence of the space.
+// It is compared with TooLargeFileError or SpaceOverCapacityError by errors.Is.
+type UploadLimitError struct {
+	// Code is TooLargeFileError or SpaceOverCapacityError.
+	Code ErrorCode
+	// Size is the size of the file in bytes.
+	Size int64
+	// Limit is the limit per file or the remaining capacity of the space in bytes.
+	Limit int64
+}
+
+func (e *UploadLimitError) Error() string {
+	if e.Code == SpaceOverCapacityError {
+		return fmt.Sprintf("file size %d bytes exceeds the remaining capacity %d bytes of the space", e.Size, e.Limit)
+	}
+	return fmt.Sprintf("file size %d bytes exceeds the limit %d bytes per file", e.Size, e.Limit)
+}
+
+// Is reports whether the target is the code of the error.
+func (e *UploadLimitError) Is(target error) bool {
+	code, ok := target.(ErrorCode)
+	return ok && code == e.Code
+}
+
+// uploadLimit is the limits of uploading fetched from the space.
+// Zero limit means no limit, and negative remaining means unknown capacity.
+type uploadLimit struct {
+	perFile   int64
+	remaining int64
+	expires   time.Time
+}
+
+// uploadLimitCall is a fetch of the limits in flight.
+// Uploads checked during the fetch wait for it instead of fetching again.
+type uploadLimitCall struct {
+	done  chan struct{}
+	limit *uploadLimit
+	err   error
+}
+
+// getUploadLimit returns the cached limits or fetches them.
+// The lock is held only to read and write the cache, so uploads are not blocked by requests of others.
+func (c *Client) getUploadLimit(ctx context.Context, now time.Time) (*uploadLimit, error) {
+	c.uploadLimitMu.Lock()
+	if l := c.uploadLimit; l != nil && now.Before(l.expires) {
+		c.uploadLimitMu.Unlock()
+		return l, nil
+	}
+	if call := c.uploadLimitCall; call != nil {
+		c.uploadLimitMu.Unlock()
+		select {
+		case <-call.done:
+			return call.limit, call.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	call := &uploadLimitCall{done: make(chan struct{})}
+	c.uploadLimitCall = call
+	c.uploadLimitMu.Unlock()
+
+	call.limit, call.err = c.fetchUploadLimit(ctx, now)
+
+	c.uploadLimitMu.Lock()
+	if call.err == nil {
+		c.uploadLimit = call.limit
+	}
+	c.uploadLimitCall = nil
+	c.uploadLimitMu.Unlock()
+	close(call.done)
+
+	return call.limit, call.err
+}
+
+// fetchUploadLimit fetches the licence and the disk usage of the space.
+// The disk usage is available only for administrators, so the capacity is unknown if it is forbidden.
+func (c *Client) fetchUploadLimit(ctx context.Context, now time.Time) (*uploadLimit, error) {
+	licence, err := c.Space.LicenceContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	l := &uploadLimit{
+		perFile:   int64(licence.AttachmentLimitPerFile),
+		remaining: -1,
+		expires:   now.Add(c.uploadLimitTTL),
+	}
+
+	usage, err := c.Space.DiskUsageContext(ctx)
+	if err != nil && !IsForbidden(err) {
+		return nil, err
+	}
+	if usage != nil {
+		capacity := int64(usage.Capacity)
+		if capacity == 0 {
+			capacity = licence.StorageLimit
+		}
+		if capacity > 0 {
+			used := int64(usage.Issue + usage.Wiki + usage.File + usage.Subversion + usage.Git + usage.GitLFS)
+			l.remaining = capacity - used
+			if l.remaining < 0 {
+				l.remaining = 0
+			}
+		}
+	}
+
+	return l, nil
+}
+
+// checkUploadLimit returns UploadLimitError if the file of the size can not be uploaded.
+// It does nothing unless the check is enabled by WithUploadLimitCheck.
+func (c *Client) checkUploadLimit(ctx context.Context, size int64) error {
+	if c.uploadLimitTTL <= 0 {
+		return nil
+	}
+
+	l, err := c.getUploadLimit(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	if l.perFile > 0 && size > l.perFile {
+		return &UploadLimitError{Code: TooLargeFileError, Size: size, Limit: l.perFile}
+	}
+	if l.remaining >= 0 && size > l.remaining {
+		return &UploadLimitError{Code: SpaceOverCapacityError, Size: size, Limit: l.remaining}
+	}
+
+	return nil
+}
@@ -0,0 +1,261 @@
+package backlog_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+)
+
+type uploadLimitServer struct {
+	t         *testing.T
+	licence   string
+	diskUsage string
+	// started and release block the request of the licence if they are set.
+	started chan struct{}
+	release chan struct{}
+
+	mu       sync.Mutex
+	requests map[string]int
+}
+
+func (s *uploadLimitServer) count(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[path]
+}
+
+func (s *uploadLimitServer) roundTrip(req *http.Request) (*http.Response, error) {
+	s.mu.Lock()
+	s.requests[req.URL.Path]++
+	s.mu.Unlock()
+
+	status, body := http.StatusOK, ""
+	switch req.URL.Path {
+	case "/api/v2/space/licence":
+		if s.started != nil {
+			s.started <- struct{}{}
+			<-s.release
+		}
+		body = s.licence
+	case "/api/v2/space/diskUsage":
+		body = s.diskUsage
+		if body == "" {
+			status, body = http.StatusForbidden, `{"errors":[{"message":"Forbidden.","code":11,"moreInfo":""}]}`
+		}
+	case "/api/v2/space/attachment":
+		ioutil.ReadAll(req.Body)
+		body = `{"id":1,"name":"test.txt","size":10}`
+	default:
+		s.t.Errorf("unexpected request: %s", req.URL.Path)
+	}
+
+	return &http.Response{
+		StatusCode: status,
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}, nil
+}
+
+func newUploadLimitClient(t *testing.T, s *uploadLimitServer, ttl time.Duration) *backlog.Client {
+	s.t = t
+	s.requests = map[string]int{}
+	c, err := backlog.NewClient(
+		"https://test.backlog.com", "apikey",
+		backlog.WithHTTPClient(NewHTTPClientMock(s.roundTrip)),
+		backlog.WithUploadLimitCheck(ttl),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestClient_uploadLimitCheck(t *testing.T) {
+	cases := map[string]struct {
+		size      int64
+		licence   string
+		diskUsage string
+		wantCode  backlog.ErrorCode
+		uploaded  bool
+	}{
+		"ok": {
+			size:      10,
+			licence:   `{"attachmentLimitPerFile":10}`,
+			diskUsage: `{"capacity":100,"issue":50,"wiki":30,"file":5,"git":5}`,
+			uploaded:  true,
+		},
+		"too_large_file": {
+			size:      11,
+			licence:   `{"attachmentLimitPerFile":10}`,
+			diskUsage: `{"capacity":100}`,
+			wantCode:  backlog.TooLargeFileError,
+		},
+		"over_capacity": {
+			size:      11,
+			licence:   `{"attachmentLimitPerFile":20}`,
+			diskUsage: `{"capacity":100,"issue":50,"wiki":30,"file":5,"git":5}`,
+			wantCode:  backlog.SpaceOverCapacityError,
+		},
+		"storage_limit": {
+			size:      11,
+			licence:   `{"storageLimit":100}`,
+			diskUsage: `{"issue":95}`,
+			wantCode:  backlog.SpaceOverCapacityError,
+		},
+		"no_limit": {
+			size:      1 << 30,
+			licence:   `{}`,
+			diskUsage: `{}`,
+			uploaded:  true,
+		},
+		"forbidden_disk_usage": {
+			size:     10,
+			licence:  `{"attachmentLimitPerFile":10}`,
+			uploaded: true,
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			s := &uploadLimitServer{licence: tc.licence, diskUsage: tc.diskUsage}
+			c := newUploadLimitClient(t, s, time.Hour)
+
+			o := c.Space.Attachment.Option
+			r := strings.NewReader(strings.Repeat("a", 10))
+			attachment, err := c.Space.Attachment.UploadReader("test.txt", r, o.WithSize(tc.size))
+			if tc.uploaded {
+				assert.NoError(t, err)
+				assert.NotNil(t, attachment)
+				assert.Equal(t, 1, s.requests["/api/v2/space/attachment"])
+				return
+			}
+
+			assert.Nil(t, attachment)
+			assert.True(t, errors.Is(err, tc.wantCode))
+			var e *backlog.UploadLimitError
+			if assert.True(t, errors.As(err, &e)) {
+				assert.Equal(t, tc.size, e.Size)
+			}
+			assert.Zero(t, s.requests["/api/v2/space/attachment"])
+		})
+	}
+}
+
+func TestClient_uploadLimitCheck_file(t *testing.T) {
+	s := &uploadLimitServer{licence: `{"attachmentLimitPerFile":1}`, diskUsage: `{}`}
+	c := newUploadLimitClient(t, s, time.Hour)
+
+	attachment, err := c.Space.Attachment.Uploade("testdata/testfile", "testfile")
+	assert.Nil(t, attachment)
+	assert.True(t, errors.Is(err, backlog.TooLargeFileError))
+	assert.Zero(t, s.requests["/api/v2/space/attachment"])
+}
+
+func TestClient_uploadLimitCheck_cache(t *testing.T) {
+	s := &uploadLimitServer{licence: `{"attachmentLimitPerFile":10}`, diskUsage: `{"capacity":100}`}
+	c := newUploadLimitClient(t, s, time.Hour)
+
+	o := c.Space.Attachment.Option
+	for i := 0; i < 3; i++ {
+		_, err := c.Space.Attachment.UploadReader("test.txt", strings.NewReader("content"), o.WithSize(7))
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, s.requests["/api/v2/space/licence"])
+	assert.Equal(t, 1, s.requests["/api/v2/space/diskUsage"])
+	assert.Equal(t, 3, s.requests["/api/v2/space/attachment"])
+}
+
+func TestClient_uploadLimitCheck_expired(t *testing.T) {
+	s := &uploadLimitServer{licence: `{"attachmentLimitPerFile":10}`, diskUsage: `{"capacity":100}`}
+	c := newUploadLimitClient(t, s, time.Nanosecond)
+
+	o := c.Space.Attachment.Option
+	for i := 0; i < 2; i++ {
+		time.Sleep(time.Millisecond)
+		_, err := c.Space.Attachment.UploadReader("test.txt", strings.NewReader("content"), o.WithSize(7))
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 2, s.requests["/api/v2/space/licence"])
+}
+
+func TestClient_uploadLimitCheck_concurrent(t *testing.T) {
+	s := &uploadLimitServer{
+		licence:   `{"attachmentLimitPerFile":10}`,
+		diskUsage: `{"capacity":100}`,
+		started:   make(chan struct{}),
+		release:   make(chan struct{}),
+	}
+	c := newUploadLimitClient(t, s, time.Hour)
+	o := c.Space.Attachment.Option
+
+	errs := make(chan error, 3)
+	go func() {
+		_, err := c.Space.Attachment.UploadReader("test.txt", strings.NewReader("content"), o.WithSize(7))
+		errs <- err
+	}()
+	<-s.started
+
+	// The upload canceled while the limits are fetched by another does not wait for it.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := c.Space.Attachment.UploadReaderContext(ctx, "test.txt", strings.NewReader("content"), o.WithSize(7))
+	assert.Equal(t, context.Canceled, err)
+
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := c.Space.Attachment.UploadReader("test.txt", strings.NewReader("content"), o.WithSize(7))
+			errs <- err
+		}()
+	}
+	close(s.release)
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, <-errs)
+	}
+	assert.Equal(t, 1, s.count("/api/v2/space/licence"))
+	assert.Equal(t, 3, s.count("/api/v2/space/attachment"))
+}
+
+func TestClient_uploadLimitCheck_unknownSize(t *testing.T) {
+	s := &uploadLimitServer{licence: `{"attachmentLimitPerFile":1}`, diskUsage: `{}`}
+	c := newUploadLimitClient(t, s, time.Hour)
+
+	attachment, err := c.Space.Attachment.UploadReader("test.txt", strings.NewReader("content"))
+	assert.NoError(t, err)
+	assert.NotNil(t, attachment)
+	assert.Zero(t, s.requests["/api/v2/space/licence"])
+}
+
+func TestClient_uploadLimitCheck_licenceError(t *testing.T) {
+	s := &uploadLimitServer{licence: `{`, diskUsage: `{}`}
+	c := newUploadLimitClient(t, s, time.Hour)
+
+	o := c.Space.Attachment.Option
+	attachment, err := c.Space.Attachment.UploadReader("test.txt", strings.NewReader("content"), o.WithSize(7))
+	assert.Nil(t, attachment)
+	assert.Error(t, err)
+	assert.Zero(t, s.requests["/api/v2/space/attachment"])
+}
+
+func TestWithUploadLimitCheck_invalied(t *testing.T) {
+	_, err := backlog.NewClient("https://test.backlog.com", "apikey", backlog.WithUploadLimitCheck(0))
+	assert.Error(t, err)
+}
+
+func TestUploadLimitError(t *testing.T) {
+	e := &backlog.UploadLimitError{Code: backlog.TooLargeFileError, Size: 11, Limit: 10}
+	assert.Equal(t, "file size 11 bytes exceeds the limit 10 bytes per file", e.Error())
+	assert.True(t, errors.Is(e, backlog.TooLargeFileError))
+	assert.False(t, errors.Is(e, backlog.SpaceOverCapacityError))
+
+	e = &backlog.UploadLimitError{Code: backlog.SpaceOverCapacityError, Size: 11, Limit: 10}
+	assert.Equal(t, "file size 11 bytes exceeds the remaining capacity 10 bytes of the space", e.Error())
+	assert.True(t, errors.Is(e, backlog.SpaceOverCapacityError))
+}
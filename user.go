@@ -1,13 +1,14 @@
 package backlog
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"strconv"
 )
 
-func getUser(get clientGet, spath string) (*User, error) {
-	resp, err := get(spath, nil)
+func getUser(ctx context.Context, get clientGet, spath string) (*User, error) {
+	resp, err := get(ctx, spath, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -21,8 +22,8 @@ func getUser(get clientGet, spath string) (*User, error) {
 	return &v, nil
 }
 
-func getUserList(get clientGet, spath string, params *requestParams) ([]*User, error) {
-	resp, err := get(spath, params)
+func getUserList(ctx context.Context, get clientGet, spath string, params *requestParams) ([]*User, error) {
+	resp, err := get(ctx, spath, params)
 	if err != nil {
 		return nil, err
 	}
@@ -36,8 +37,8 @@ func getUserList(get clientGet, spath string, params *requestParams) ([]*User, e
 	return v, nil
 }
 
-func addUser(post clientPost, spath string, params *requestParams) (*User, error) {
-	resp, err := post(spath, params)
+func addUser(ctx context.Context, post clientPost, spath string, params *requestParams) (*User, error) {
+	resp, err := post(ctx, spath, params)
 	if err != nil {
 		return nil, err
 	}
@@ -51,8 +52,8 @@ func addUser(post clientPost, spath string, params *requestParams) (*User, error
 	return &v, nil
 }
 
-func updateUser(patch clientPatch, spath string, params *requestParams) (*User, error) {
-	resp, err := patch(spath, params)
+func updateUser(ctx context.Context, patch clientPatch, spath string, params *requestParams) (*User, error) {
+	resp, err := patch(ctx, spath, params)
 	if err != nil {
 		return nil, err
 	}
@@ -66,8 +67,8 @@ func updateUser(patch clientPatch, spath string, params *requestParams) (*User,
 	return &v, nil
 }
 
-func deleteUser(delete clientDelete, spath string, params *requestParams) (*User, error) {
-	resp, err := delete(spath, params)
+func deleteUser(ctx context.Context, delete clientDelete, spath string, params *requestParams) (*User, error) {
+	resp, err := delete(ctx, spath, params)
 	if err != nil {
 		return nil, err
 	}
@@ -93,28 +94,43 @@ type UserService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-user-list
 func (s *UserService) All() ([]*User, error) {
+	return s.AllContext(context.Background())
+}
+
+// AllContext is like All but with the context.
+func (s *UserService) AllContext(ctx context.Context) ([]*User, error) {
 	spath := "users"
-	return getUserList(s.method.Get, spath, nil)
+	return getUserList(ctx, s.method.Get, spath, nil)
 }
 
 // One returns a user in your space.
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-user
 func (s *UserService) One(id int) (*User, error) {
+	return s.OneContext(context.Background(), id)
+}
+
+// OneContext is like One but with the context.
+func (s *UserService) OneContext(ctx context.Context, id int) (*User, error) {
 	if id < 1 {
 		return nil, errors.New("id must be greater than 1")
 	}
 
 	spath := "users/" + strconv.Itoa(id)
-	return getUser(s.method.Get, spath)
+	return getUser(ctx, s.method.Get, spath)
 }
 
 // Own returns your own user.
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-own-user
 func (s *UserService) Own() (*User, error) {
+	return s.OwnContext(context.Background())
+}
+
+// OwnContext is like Own but with the context.
+func (s *UserService) OwnContext(ctx context.Context) (*User, error) {
 	spath := "users/myself"
-	return getUser(s.method.Get, spath)
+	return getUser(ctx, s.method.Get, spath)
 }
 
 // ToDo: func (s *UserService) Icon()
@@ -124,6 +140,11 @@ func (s *UserService) Own() (*User, error) {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-user
 func (s *UserService) Add(userID, password, name, mailAddress string, roleType role) (*User, error) {
+	return s.AddContext(context.Background(), userID, password, name, mailAddress, roleType)
+}
+
+// AddContext is like Add but with the context.
+func (s *UserService) AddContext(ctx context.Context, userID, password, name, mailAddress string, roleType role) (*User, error) {
 	if userID == "" {
 		return nil, errors.New("userID must not be empty")
 	}
@@ -145,13 +166,18 @@ func (s *UserService) Add(userID, password, name, mailAddress string, roleType r
 	params.Add("roleType", strconv.Itoa(int(roleType)))
 
 	spath := "users"
-	return addUser(s.method.Post, spath, params)
+	return addUser(ctx, s.method.Post, spath, params)
 }
 
 // Update updates a user in your space.
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-user
 func (s *UserService) Update(id int, options ...UserOption) (*User, error) {
+	return s.UpdateContext(context.Background(), id, options...)
+}
+
+// UpdateContext is like Update but with the context.
+func (s *UserService) UpdateContext(ctx context.Context, id int, options ...UserOption) (*User, error) {
 	if id < 1 {
 		return nil, errors.New("id must be greater than 1")
 	}
@@ -165,19 +191,24 @@ func (s *UserService) Update(id int, options ...UserOption) (*User, error) {
 		}
 	}
 
-	return updateUser(s.method.Patch, spath, params)
+	return updateUser(ctx, s.method.Patch, spath, params)
 }
 
 // Delete deletes a user from your space.
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-user
 func (s *UserService) Delete(id int) (*User, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext is like Delete but with the context.
+func (s *UserService) DeleteContext(ctx context.Context, id int) (*User, error) {
 	if id < 1 {
 		return nil, errors.New("id must be greater than 1")
 	}
 
 	spath := "users/" + strconv.Itoa(id)
-	return deleteUser(s.method.Delete, spath, nil)
+	return deleteUser(ctx, s.method.Delete, spath, nil)
 }
 
 // ProjectUserService has methods for user of project.
@@ -189,6 +220,11 @@ type ProjectUserService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-project-user-list
 func (s *ProjectUserService) All(target ProjectIDOrKeyGetter, excludeGroupMembers bool) ([]*User, error) {
+	return s.AllContext(context.Background(), target, excludeGroupMembers)
+}
+
+// AllContext is like All but with the context.
+func (s *ProjectUserService) AllContext(ctx context.Context, target ProjectIDOrKeyGetter, excludeGroupMembers bool) ([]*User, error) {
 	projectIDOrKey, err := target.getProjectIDOrKey()
 	if err != nil {
 		return nil, err
@@ -198,13 +234,18 @@ func (s *ProjectUserService) All(target ProjectIDOrKeyGetter, excludeGroupMember
 	params.Add("excludeGroupMembers", strconv.FormatBool(excludeGroupMembers))
 
 	spath := "projects/" + projectIDOrKey + "/users"
-	return getUserList(s.method.Get, spath, params)
+	return getUserList(ctx, s.method.Get, spath, params)
 }
 
 // Add adds a user to the project.
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-project-user
 func (s *ProjectUserService) Add(target ProjectIDOrKeyGetter, userID int) (*User, error) {
+	return s.AddContext(context.Background(), target, userID)
+}
+
+// AddContext is like Add but with the context.
+func (s *ProjectUserService) AddContext(ctx context.Context, target ProjectIDOrKeyGetter, userID int) (*User, error) {
 	projectIDOrKey, err := target.getProjectIDOrKey()
 	if err != nil {
 		return nil, err
@@ -217,13 +258,18 @@ func (s *ProjectUserService) Add(target ProjectIDOrKeyGetter, userID int) (*User
 	params.Add("userId", strconv.Itoa(userID))
 
 	spath := "projects/" + projectIDOrKey + "/users"
-	return addUser(s.method.Post, spath, params)
+	return addUser(ctx, s.method.Post, spath, params)
 }
 
 // Delete deletes a user from the project.
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-project-user
 func (s *ProjectUserService) Delete(target ProjectIDOrKeyGetter, userID int) (*User, error) {
+	return s.DeleteContext(context.Background(), target, userID)
+}
+
+// DeleteContext is like Delete but with the context.
+func (s *ProjectUserService) DeleteContext(ctx context.Context, target ProjectIDOrKeyGetter, userID int) (*User, error) {
 	projectIDOrKey, err := target.getProjectIDOrKey()
 	if err != nil {
 		return nil, err
@@ -236,13 +282,18 @@ func (s *ProjectUserService) Delete(target ProjectIDOrKeyGetter, userID int) (*U
 	params.Add("userId", strconv.Itoa(userID))
 
 	spath := "projects/" + projectIDOrKey + "/users"
-	return deleteUser(s.method.Delete, spath, params)
+	return deleteUser(ctx, s.method.Delete, spath, params)
 }
 
 // AddAdmin adds a admin user to the project.
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-project-administrator
 func (s *ProjectUserService) AddAdmin(target ProjectIDOrKeyGetter, userID int) (*User, error) {
+	return s.AddAdminContext(context.Background(), target, userID)
+}
+
+// AddAdminContext is like AddAdmin but with the context.
+func (s *ProjectUserService) AddAdminContext(ctx context.Context, target ProjectIDOrKeyGetter, userID int) (*User, error) {
 	projectIDOrKey, err := target.getProjectIDOrKey()
 	if err != nil {
 		return nil, err
@@ -255,26 +306,36 @@ func (s *ProjectUserService) AddAdmin(target ProjectIDOrKeyGetter, userID int) (
 	params.Add("userId", strconv.Itoa(userID))
 
 	spath := "projects/" + projectIDOrKey + "/administrators"
-	return addUser(s.method.Post, spath, params)
+	return addUser(ctx, s.method.Post, spath, params)
 }
 
 // AdminAll returns all of admin users in the project.
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-list-of-project-administrators
 func (s *ProjectUserService) AdminAll(target ProjectIDOrKeyGetter) ([]*User, error) {
+	return s.AdminAllContext(context.Background(), target)
+}
+
+// AdminAllContext is like AdminAll but with the context.
+func (s *ProjectUserService) AdminAllContext(ctx context.Context, target ProjectIDOrKeyGetter) ([]*User, error) {
 	projectIDOrKey, err := target.getProjectIDOrKey()
 	if err != nil {
 		return nil, err
 	}
 
 	spath := "projects/" + projectIDOrKey + "/administrators"
-	return getUserList(s.method.Get, spath, nil)
+	return getUserList(ctx, s.method.Get, spath, nil)
 }
 
 // DeleteAdmin deletes a admin user from the project.
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-project-administrator
 func (s *ProjectUserService) DeleteAdmin(target ProjectIDOrKeyGetter, userID int) (*User, error) {
+	return s.DeleteAdminContext(context.Background(), target, userID)
+}
+
+// DeleteAdminContext is like DeleteAdmin but with the context.
+func (s *ProjectUserService) DeleteAdminContext(ctx context.Context, target ProjectIDOrKeyGetter, userID int) (*User, error) {
 	projectIDOrKey, err := target.getProjectIDOrKey()
 	if err != nil {
 		return nil, err
@@ -287,5 +348,5 @@ func (s *ProjectUserService) DeleteAdmin(target ProjectIDOrKeyGetter, userID int
 	params.Add("userId", strconv.Itoa(userID))
 
 	spath := "projects/" + projectIDOrKey + "/administrators"
-	return deleteUser(s.method.Delete, spath, params)
+	return deleteUser(ctx, s.method.Delete, spath, params)
 }
@@ -1,6 +1,7 @@
 package backlog_test
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"os"
@@ -22,7 +23,7 @@ func TestUserService_One_getUser(t *testing.T) {
 	}
 	s := &backlog.UserService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, "users/1", spath)
 			assert.Nil(t, params)
 
@@ -55,7 +56,7 @@ func TestProjectUserService_All_getUserList(t *testing.T) {
 	}
 	s := &backlog.ProjectUserService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, "projects/"+projectKey+"/users", spath)
 			assert.Equal(t, strconv.FormatBool(excludeGroupMembers), params.Get("excludeGroupMembers"))
 			resp := &http.Response{
@@ -85,7 +86,7 @@ func TestUserService_Add_addUser(t *testing.T) {
 	}
 	s := &backlog.UserService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Post: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, "users", spath)
 			assert.Equal(t, userID, params.Get("userId"))
 			assert.Equal(t, password, params.Get("password"))
@@ -121,7 +122,7 @@ func TestProjectUserService_Delete_deleteUser(t *testing.T) {
 	}
 	s := &backlog.ProjectUserService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, "projects/"+projectKey+"/users", spath)
 			assert.Equal(t, strconv.Itoa(id), params.Get("userId"))
 			resp := &http.Response{
@@ -152,7 +153,7 @@ func TestUserService_Update_updateUser(t *testing.T) {
 	}
 	s := &backlog.UserService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Patch: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, "users/"+strconv.Itoa(id), spath)
 			assert.Equal(t, name, params.Get("name"))
 			assert.Equal(t, password, params.Get("password"))
@@ -185,7 +186,7 @@ func TestUserService_All(t *testing.T) {
 	}
 	s := &backlog.UserService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			assert.Nil(t, params)
 			return nil, errors.New("error")
@@ -205,7 +206,7 @@ func TestUserService_All_invaliedJson(t *testing.T) {
 
 	s := &backlog.UserService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -253,7 +254,7 @@ func TestUserService_One(t *testing.T) {
 		t.Run(n, func(t *testing.T) {
 			s := &backlog.UserService{}
 			s.ExportSetMethod(&backlog.ExportMethod{
-				Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+				Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 					if tc.wantError {
 						t.Error("s.method.Get must never be called")
 					} else {
@@ -276,7 +277,7 @@ func TestUserService_Own(t *testing.T) {
 	}
 	s := &backlog.UserService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			assert.Nil(t, params)
 			return nil, errors.New("error")
@@ -296,7 +297,7 @@ func TestUserService_Own_invaliedJson(t *testing.T) {
 
 	s := &backlog.UserService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -365,7 +366,7 @@ func TestUserService_Add(t *testing.T) {
 		t.Run(n, func(t *testing.T) {
 			s := &backlog.UserService{}
 			s.ExportSetMethod(&backlog.ExportMethod{
-				Post: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+				Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 					if tc.wantError {
 						t.Error("s.method.Post must never be called")
 					} else {
@@ -395,7 +396,7 @@ func TestUserService_Add_invaliedJson(t *testing.T) {
 
 	s := &backlog.UserService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Post: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -427,7 +428,7 @@ func TestUserService_Update(t *testing.T) {
 		t.Run(n, func(t *testing.T) {
 			s := &backlog.UserService{}
 			s.ExportSetMethod(&backlog.ExportMethod{
-				Patch: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+				Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 					if tc.wantError {
 						t.Error("s.method.Patch must never be called")
 					} else {
@@ -541,7 +542,7 @@ func TestUserService_Update_option(t *testing.T) {
 		t.Run(n, func(t *testing.T) {
 			s := &backlog.UserService{}
 			s.ExportSetMethod(&backlog.ExportMethod{
-				Patch: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+				Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 					if tc.wantError {
 						t.Error("s.method.Patch must never be called")
 					} else {
@@ -571,7 +572,7 @@ func TestUserService_Update_invaliedJson(t *testing.T) {
 
 	s := &backlog.UserService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Patch: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -619,7 +620,7 @@ func TestUserService_Delete(t *testing.T) {
 		t.Run(n, func(t *testing.T) {
 			s := &backlog.UserService{}
 			s.ExportSetMethod(&backlog.ExportMethod{
-				Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+				Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 					if tc.wantError {
 						t.Error("s.method.Delete must never be called")
 					} else {
@@ -645,7 +646,7 @@ func TestUserService_Delete_invaliedJson(t *testing.T) {
 
 	s := &backlog.UserService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -708,7 +709,7 @@ func TestProjectUserService_All(t *testing.T) {
 		t.Run(n, func(t *testing.T) {
 			s := &backlog.ProjectUserService{}
 			s.ExportSetMethod(&backlog.ExportMethod{
-				Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+				Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 					if tc.wantError {
 						t.Error("s.method.Get must never be called")
 					} else {
@@ -770,7 +771,7 @@ func TestProjectUserService_Add(t *testing.T) {
 		t.Run(n, func(t *testing.T) {
 			s := &backlog.ProjectUserService{}
 			s.ExportSetMethod(&backlog.ExportMethod{
-				Post: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+				Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 					if tc.wantError {
 						t.Error("s.method.Post must never be called")
 					} else {
@@ -840,7 +841,7 @@ func TestProjectUserService_Delete(t *testing.T) {
 		t.Run(n, func(t *testing.T) {
 			s := &backlog.ProjectUserService{}
 			s.ExportSetMethod(&backlog.ExportMethod{
-				Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+				Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 					if tc.wantError {
 						t.Error("s.method.Delete must never be called")
 					} else {
@@ -901,7 +902,7 @@ func TestProjectUserService_AddAdmin(t *testing.T) {
 		t.Run(n, func(t *testing.T) {
 			s := &backlog.ProjectUserService{}
 			s.ExportSetMethod(&backlog.ExportMethod{
-				Post: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+				Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 					if tc.wantError {
 						t.Error("s.method.Post must never be called")
 					} else {
@@ -942,7 +943,7 @@ func TestProjectUserService_AdminAll(t *testing.T) {
 		t.Run(n, func(t *testing.T) {
 			s := &backlog.ProjectUserService{}
 			s.ExportSetMethod(&backlog.ExportMethod{
-				Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+				Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 					if tc.wantError {
 						t.Error("s.method.Get must never be called")
 					} else {
@@ -1003,7 +1004,7 @@ func TestProjectUserService_DeleteAdmin(t *testing.T) {
 		t.Run(n, func(t *testing.T) {
 			s := &backlog.ProjectUserService{}
 			s.ExportSetMethod(&backlog.ExportMethod{
-				Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+				Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 					if tc.wantError {
 						t.Error("s.method.Delete must never be called")
 					} else {
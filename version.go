@@ -1,6 +1,155 @@
 package backlog
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+func decodeVersion(resp *response) (*Version, error) {
+	defer resp.Body.Close()
+
+	v := Version{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
 // VersionService has methods for Version.
 type VersionService struct {
 	method *method
+
+	Option *VersionOptionService
+}
+
+// All returns a list of versions (milestones) in the project.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-version-milestone-list
+func (s *VersionService) All(target ProjectIDOrKeyGetter) ([]*Version, error) {
+	return s.AllContext(context.Background(), target)
+}
+
+// AllContext is like All but with the context.
+func (s *VersionService) AllContext(ctx context.Context, target ProjectIDOrKeyGetter) ([]*Version, error) {
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return nil, err
+	}
+	spath := "projects/" + projectIDOrKey + "/versions"
+
+	resp, err := s.method.Get(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := []*Version{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// Create creates a new version (milestone) in the project.
+//
+// This method supports options returned by methods in "*Client.Project.Version.Option".
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-version-milestone
+func (s *VersionService) Create(target ProjectIDOrKeyGetter, name string, options ...VersionOption) (*Version, error) {
+	return s.CreateContext(context.Background(), target, name, options...)
+}
+
+// CreateContext is like Create but with the context.
+func (s *VersionService) CreateContext(ctx context.Context, target ProjectIDOrKeyGetter, name string, options ...VersionOption) (*Version, error) {
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return nil, err
+	}
+	spath := "projects/" + projectIDOrKey + "/versions"
+
+	params := newRequestParams()
+	if err := withName(name)(params); err != nil {
+		return nil, err
+	}
+	for _, option := range options {
+		if err := option(params); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.method.Post(ctx, spath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeVersion(resp)
+}
+
+// Update updates the version (milestone) in the project.
+// The name is required by Backlog API even if it is not changed.
+//
+// This method supports options returned by methods in "*Client.Project.Version.Option".
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-version-milestone
+func (s *VersionService) Update(target ProjectIDOrKeyGetter, versionID int, name string, options ...VersionOption) (*Version, error) {
+	return s.UpdateContext(context.Background(), target, versionID, name, options...)
+}
+
+// UpdateContext is like Update but with the context.
+func (s *VersionService) UpdateContext(ctx context.Context, target ProjectIDOrKeyGetter, versionID int, name string, options ...VersionOption) (*Version, error) {
+	if versionID < 1 {
+		return nil, fmt.Errorf("versionID must be 1 or more: %d", versionID)
+	}
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return nil, err
+	}
+	spath := "projects/" + projectIDOrKey + "/versions/" + strconv.Itoa(versionID)
+
+	params := newRequestParams()
+	if err := withName(name)(params); err != nil {
+		return nil, err
+	}
+	for _, option := range options {
+		if err := option(params); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.method.Patch(ctx, spath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeVersion(resp)
+}
+
+// Delete deletes the version (milestone) in the project.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-version
+func (s *VersionService) Delete(target ProjectIDOrKeyGetter, versionID int) (*Version, error) {
+	return s.DeleteContext(context.Background(), target, versionID)
+}
+
+// DeleteContext is like Delete but with the context.
+func (s *VersionService) DeleteContext(ctx context.Context, target ProjectIDOrKeyGetter, versionID int) (*Version, error) {
+	if versionID < 1 {
+		return nil, fmt.Errorf("versionID must be 1 or more: %d", versionID)
+	}
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return nil, err
+	}
+	spath := "projects/" + projectIDOrKey + "/versions/" + strconv.Itoa(versionID)
+
+	resp, err := s.method.Delete(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeVersion(resp)
 }
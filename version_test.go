@@ -0,0 +1,167 @@
+package backlog_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/nattokin/go-backlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionService_All(t *testing.T) {
+	bj, err := os.Open("testdata/json/version_list.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.VersionService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/versions", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	versions, err := s.All(backlog.ProjectKey("TEST"))
+	assert.NoError(t, err)
+	assert.Len(t, versions, 2)
+	assert.True(t, versions[0].StartDate.IsZero())
+	assert.Equal(t, "v1.0", versions[1].Name)
+	assert.True(t, versions[1].Archived)
+}
+
+func TestVersionService_Create(t *testing.T) {
+	bj, err := os.Open("testdata/json/version.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.VersionService{
+		Option: &backlog.VersionOptionService{},
+	}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/versions", spath)
+			assert.Equal(t, "v1.0", params.Get("name"))
+			assert.Equal(t, "first release", params.Get("description"))
+			assert.Equal(t, "2019-10-01", params.Get("startDate"))
+			assert.Equal(t, "2019-10-31", params.Get("releaseDueDate"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	o := s.Option
+	version, err := s.Create(backlog.ProjectKey("TEST"), "v1.0",
+		o.WithDescription("first release"),
+		o.WithStartDate(backlog.NewDate(2019, time.October, 1)),
+		o.WithReleaseDueDate(backlog.NewDate(2019, time.October, 31)),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, version.ID)
+}
+
+func TestVersionService_Update(t *testing.T) {
+	bj, err := os.Open("testdata/json/version.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.VersionService{
+		Option: &backlog.VersionOptionService{},
+	}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/versions/4", spath)
+			assert.Equal(t, "v1.0", params.Get("name"))
+			assert.Equal(t, "true", params.Get("archived"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	version, err := s.Update(backlog.ProjectKey("TEST"), 4, "v1.0", s.Option.WithArchived(true))
+	assert.NoError(t, err)
+	assert.True(t, version.Archived)
+}
+
+func TestVersionService_Delete(t *testing.T) {
+	bj, err := os.Open("testdata/json/version.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.VersionService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "projects/TEST/versions/4", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	version, err := s.Delete(backlog.ProjectKey("TEST"), 4)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, version.ID)
+}
+
+func TestVersionService_param_error(t *testing.T) {
+	s := &backlog.VersionService{
+		Option: &backlog.VersionOptionService{},
+	}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Post must never be called")
+			return nil, errors.New("error")
+		},
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Patch must never be called")
+			return nil, errors.New("error")
+		},
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Delete must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	version, err := s.Create(backlog.ProjectKey("TEST"), "")
+	assert.Nil(t, version)
+	assert.Error(t, err)
+
+	version, err = s.Create(backlog.ProjectKey("TEST"), "v1.0", s.Option.WithStartDate(backlog.Date{}))
+	assert.Nil(t, version)
+	assert.Error(t, err)
+
+	version, err = s.Update(backlog.ProjectKey("TEST"), 0, "v1.0")
+	assert.Nil(t, version)
+	assert.Error(t, err)
+
+	version, err = s.Update(backlog.ProjectKey("TEST"), 4, "")
+	assert.Nil(t, version)
+	assert.Error(t, err)
+
+	version, err = s.Delete(backlog.ProjectKey("TEST"), 0)
+	assert.Nil(t, version)
+	assert.Error(t, err)
+}
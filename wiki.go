@@ -1,6 +1,7 @@
 package backlog
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,6 +13,9 @@ type WikiService struct {
 	method *method
 
 	Attachment *WikiAttachmentService
+	History    *WikiHistoryService
+	Star       *WikiStarService
+	Tag        *WikiTagService
 	Option     *WikiOptionService
 }
 
@@ -19,13 +23,23 @@ type WikiService struct {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-wiki-page-list
 func (s *WikiService) All(target ProjectIDOrKeyGetter) ([]*Wiki, error) {
-	return s.Search(target, "")
+	return s.AllContext(context.Background(), target)
+}
+
+// AllContext is like All but with the context.
+func (s *WikiService) AllContext(ctx context.Context, target ProjectIDOrKeyGetter) ([]*Wiki, error) {
+	return s.SearchContext(ctx, target, "")
 }
 
 // Search returns wikis by keyword from within the project.
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-wiki-page-list
 func (s *WikiService) Search(target ProjectIDOrKeyGetter, keyword string) ([]*Wiki, error) {
+	return s.SearchContext(context.Background(), target, keyword)
+}
+
+// SearchContext is like Search but with the context.
+func (s *WikiService) SearchContext(ctx context.Context, target ProjectIDOrKeyGetter, keyword string) ([]*Wiki, error) {
 	params := newRequestParams()
 	projectIDOrKey, err := target.getProjectIDOrKey()
 	if err != nil {
@@ -35,7 +49,7 @@ func (s *WikiService) Search(target ProjectIDOrKeyGetter, keyword string) ([]*Wi
 	if keyword != "" {
 		params.Set("keyword", keyword)
 	}
-	resp, err := s.method.Get("wikis", params)
+	resp, err := s.method.Get(ctx, "wikis", params)
 	if err != nil {
 		return nil, err
 	}
@@ -53,13 +67,18 @@ func (s *WikiService) Search(target ProjectIDOrKeyGetter, keyword string) ([]*Wi
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/count-wiki-page
 func (s *WikiService) Count(target ProjectIDOrKeyGetter) (int, error) {
+	return s.CountContext(context.Background(), target)
+}
+
+// CountContext is like Count but with the context.
+func (s *WikiService) CountContext(ctx context.Context, target ProjectIDOrKeyGetter) (int, error) {
 	params := newRequestParams()
 	projectIDOrKey, err := target.getProjectIDOrKey()
 	if err != nil {
 		return 0, err
 	}
 	params.Set("projectIdOrKey", projectIDOrKey)
-	resp, err := s.method.Get("wikis/count", params)
+	resp, err := s.method.Get(ctx, "wikis/count", params)
 	if err != nil {
 		return 0, err
 	}
@@ -77,12 +96,17 @@ func (s *WikiService) Count(target ProjectIDOrKeyGetter) (int, error) {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-wiki-page
 func (s *WikiService) One(wikiID int) (*Wiki, error) {
+	return s.OneContext(context.Background(), wikiID)
+}
+
+// OneContext is like One but with the context.
+func (s *WikiService) OneContext(ctx context.Context, wikiID int) (*Wiki, error) {
 	if wikiID <= 0 {
 		return nil, fmt.Errorf("wikiID must be 1 or more: %d", wikiID)
 	}
 
 	spath := "wikis/" + strconv.Itoa(wikiID)
-	resp, err := s.method.Get(spath, nil)
+	resp, err := s.method.Get(ctx, spath, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -100,6 +124,11 @@ func (s *WikiService) One(wikiID int) (*Wiki, error) {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/add-wiki-page
 func (s *WikiService) Create(projectID int, name, content string, options ...WikiOption) (*Wiki, error) {
+	return s.CreateContext(context.Background(), projectID, name, content, options...)
+}
+
+// CreateContext is like Create but with the context.
+func (s *WikiService) CreateContext(ctx context.Context, projectID int, name, content string, options ...WikiOption) (*Wiki, error) {
 	if projectID == 0 {
 		return nil, errors.New("projectID must not be zero")
 	}
@@ -120,7 +149,7 @@ func (s *WikiService) Create(projectID int, name, content string, options ...Wik
 		}
 	}
 
-	resp, err := s.method.Post("wikis", params)
+	resp, err := s.method.Post(ctx, "wikis", params)
 	if err != nil {
 		return nil, err
 	}
@@ -138,6 +167,11 @@ func (s *WikiService) Create(projectID int, name, content string, options ...Wik
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/update-wiki-page
 func (s *WikiService) Update(wikiID int, options ...WikiOption) (*Wiki, error) {
+	return s.UpdateContext(context.Background(), wikiID, options...)
+}
+
+// UpdateContext is like Update but with the context.
+func (s *WikiService) UpdateContext(ctx context.Context, wikiID int, options ...WikiOption) (*Wiki, error) {
 	if wikiID <= 0 {
 		return nil, fmt.Errorf("wikiID must be 1 or more: %d", wikiID)
 	}
@@ -154,7 +188,7 @@ func (s *WikiService) Update(wikiID int, options ...WikiOption) (*Wiki, error) {
 	}
 
 	spath := "wikis/" + strconv.Itoa(wikiID)
-	resp, err := s.method.Patch(spath, params)
+	resp, err := s.method.Patch(ctx, spath, params)
 	if err != nil {
 		return nil, err
 	}
@@ -172,6 +206,11 @@ func (s *WikiService) Update(wikiID int, options ...WikiOption) (*Wiki, error) {
 //
 // Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/delete-wiki-page
 func (s *WikiService) Delete(wikiID int, options ...WikiOption) (*Wiki, error) {
+	return s.DeleteContext(context.Background(), wikiID, options...)
+}
+
+// DeleteContext is like Delete but with the context.
+func (s *WikiService) DeleteContext(ctx context.Context, wikiID int, options ...WikiOption) (*Wiki, error) {
 	if wikiID <= 0 {
 		return nil, fmt.Errorf("wikiID must be 1 or more: %d", wikiID)
 	}
@@ -183,7 +222,7 @@ func (s *WikiService) Delete(wikiID int, options ...WikiOption) (*Wiki, error) {
 	}
 
 	spath := "wikis/" + strconv.Itoa(wikiID)
-	resp, err := s.method.Delete(spath, params)
+	resp, err := s.method.Delete(ctx, spath, params)
 	if err != nil {
 		return nil, err
 	}
@@ -196,3 +235,237 @@ func (s *WikiService) Delete(wikiID int, options ...WikiOption) (*Wiki, error) {
 
 	return &v, nil
 }
+
+// Diff returns the difference of content between two versions of the wiki in unified format.
+// If toVersion is 0, the version is compared with the current content.
+// It returns empty string if the contents have the same lines.
+func (s *WikiService) Diff(wikiID, fromVersion, toVersion int) (string, error) {
+	return s.DiffContext(context.Background(), wikiID, fromVersion, toVersion)
+}
+
+// DiffContext is like Diff but with the context.
+func (s *WikiService) DiffContext(ctx context.Context, wikiID, fromVersion, toVersion int) (string, error) {
+	if wikiID <= 0 {
+		return "", newClientError(fmt.Sprintf("wikiID must be 1 or more: %d", wikiID))
+	}
+	if fromVersion <= 0 {
+		return "", newClientError(fmt.Sprintf("fromVersion must be 1 or more: %d", fromVersion))
+	}
+	if toVersion < 0 {
+		return "", newClientError(fmt.Sprintf("toVersion must be 0 or more: %d", toVersion))
+	}
+
+	history := &WikiHistoryService{method: s.method}
+	from, err := history.OneContext(ctx, wikiID, fromVersion)
+	if err != nil {
+		return "", err
+	}
+
+	if toVersion == 0 {
+		wiki, err := s.OneContext(ctx, wikiID)
+		if err != nil {
+			return "", err
+		}
+		return unifiedDiff(wikiVersionLabel(from), wiki.Name+" (current)", from.Content, wiki.Content), nil
+	}
+
+	to, err := history.OneContext(ctx, wikiID, toVersion)
+	if err != nil {
+		return "", err
+	}
+
+	return unifiedDiff(wikiVersionLabel(from), wikiVersionLabel(to), from.Content, to.Content), nil
+}
+
+func wikiVersionLabel(h *WikiHistory) string {
+	return h.Name + " (version " + strconv.Itoa(h.Version) + ")"
+}
+
+// Restore updates the wiki to the name and content of the version.
+//
+// This method supports options returned by methods in "*Client.Wiki.Option".
+// They are applied after the name and content of the version.
+func (s *WikiService) Restore(wikiID, version int, options ...WikiOption) (*Wiki, error) {
+	return s.RestoreContext(context.Background(), wikiID, version, options...)
+}
+
+// RestoreContext is like Restore but with the context.
+func (s *WikiService) RestoreContext(ctx context.Context, wikiID, version int, options ...WikiOption) (*Wiki, error) {
+	history := &WikiHistoryService{method: s.method}
+	h, err := history.OneContext(ctx, wikiID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	content := SetString(h.Content)
+	if h.Content == "" {
+		content = ClearString()
+	}
+	restore := []WikiOption{
+		WikiOption(withName(h.Name)),
+		WikiOption(withOptionalString("content", content, true, withContent)),
+	}
+
+	return s.UpdateContext(ctx, wikiID, append(restore, options...)...)
+}
+
+// WikiTagService has methods for tags of wiki.
+type WikiTagService struct {
+	method *method
+}
+
+// All returns a list of tags used in the project.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-wiki-page-tag-list
+func (s *WikiTagService) All(target ProjectIDOrKeyGetter) ([]*Tag, error) {
+	return s.AllContext(context.Background(), target)
+}
+
+// AllContext is like All but with the context.
+func (s *WikiTagService) AllContext(ctx context.Context, target ProjectIDOrKeyGetter) ([]*Tag, error) {
+	projectIDOrKey, err := target.getProjectIDOrKey()
+	if err != nil {
+		return nil, err
+	}
+	params := newRequestParams()
+	params.Set("projectIdOrKey", projectIDOrKey)
+
+	resp, err := s.method.Get(ctx, "wikis/tags", params)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := []*Tag{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+func fetchWikiHistoryList(ctx context.Context, get clientGet, spath string, params *requestParams) ([]*WikiHistory, error) {
+	resp, err := get(ctx, spath, params)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := []*WikiHistory{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// WikiHistoryService has methods for history of wiki.
+type WikiHistoryService struct {
+	method *method
+
+	Option *WikiHistoryOptionService
+}
+
+// List returns a list of versions of the wiki.
+//
+// This method supports options returned by methods in "*Client.Wiki.History.Option".
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-wiki-page-history
+func (s *WikiHistoryService) List(wikiID int, options ...WikiHistoryOption) ([]*WikiHistory, error) {
+	return s.ListContext(context.Background(), wikiID, options...)
+}
+
+// ListContext is like List but with the context.
+func (s *WikiHistoryService) ListContext(ctx context.Context, wikiID int, options ...WikiHistoryOption) ([]*WikiHistory, error) {
+	if wikiID <= 0 {
+		return nil, fmt.Errorf("wikiID must be 1 or more: %d", wikiID)
+	}
+
+	params := newRequestParams()
+	for _, option := range options {
+		if err := option(params); err != nil {
+			return nil, err
+		}
+	}
+
+	spath := "wikis/" + strconv.Itoa(wikiID) + "/history"
+	return fetchWikiHistoryList(ctx, s.method.Get, spath, params)
+}
+
+// Iterate returns an iterator of all versions of the wiki.
+// The history has no ID, so it fetches pages by maxId or minId with the version of the last item.
+//
+// This method supports options returned by methods in "*Client.Wiki.History.Option".
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-wiki-page-history
+func (s *WikiHistoryService) Iterate(wikiID int, options ...WikiHistoryOption) *WikiHistoryIterator {
+	return s.IterateContext(context.Background(), wikiID, options...)
+}
+
+// IterateContext is like Iterate but with the context.
+func (s *WikiHistoryService) IterateContext(ctx context.Context, wikiID int, options ...WikiHistoryOption) *WikiHistoryIterator {
+	if wikiID <= 0 {
+		return &WikiHistoryIterator{pager: &pager{err: fmt.Errorf("wikiID must be 1 or more: %d", wikiID)}}
+	}
+
+	spath := "wikis/" + strconv.Itoa(wikiID) + "/history"
+	return newWikiHistoryIterator(ctx, s.method.Get, spath, options)
+}
+
+// One returns the version of the wiki.
+// It gets the newest version up to the version by maxId, so it sends only one request.
+func (s *WikiHistoryService) One(wikiID, version int) (*WikiHistory, error) {
+	return s.OneContext(context.Background(), wikiID, version)
+}
+
+// OneContext is like One but with the context.
+func (s *WikiHistoryService) OneContext(ctx context.Context, wikiID, version int) (*WikiHistory, error) {
+	if version <= 0 {
+		return nil, newClientError(fmt.Sprintf("version must be 1 or more: %d", version))
+	}
+
+	histories, err := s.ListContext(ctx, wikiID,
+		WikiHistoryOption(withMaxID(version)), WikiHistoryOption(withCount(1)), WikiHistoryOption(withOrder(OrderDesc)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(histories) > 0 && histories[0].Version == version {
+		return histories[0], nil
+	}
+
+	return nil, newClientError(fmt.Sprintf("version %d of wiki %d is not found", version, wikiID))
+}
+
+// WikiStarService has methods for stars of wiki.
+type WikiStarService struct {
+	method *method
+}
+
+// List returns a list of stars on the wiki.
+//
+// Backlog API docs: https://developer.nulab.com/docs/backlog/api/2/get-wiki-page-star
+func (s *WikiStarService) List(wikiID int) ([]*Star, error) {
+	return s.ListContext(context.Background(), wikiID)
+}
+
+// ListContext is like List but with the context.
+func (s *WikiStarService) ListContext(ctx context.Context, wikiID int) ([]*Star, error) {
+	if wikiID <= 0 {
+		return nil, fmt.Errorf("wikiID must be 1 or more: %d", wikiID)
+	}
+
+	spath := "wikis/" + strconv.Itoa(wikiID) + "/stars"
+	resp, err := s.method.Get(ctx, spath, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v := []*Star{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
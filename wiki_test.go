@@ -1,9 +1,14 @@
 package backlog_test
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io/ioutil"
+	"math"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
@@ -32,7 +37,7 @@ func TestWikiService_All(t *testing.T) {
 	}
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			assert.Equal(t, want.projectIDOrKey, params.Get("projectIdOrKey"))
 			assert.Equal(t, want.keyword, params.Get("keyword"))
@@ -74,7 +79,7 @@ func TestWikiService_Search(t *testing.T) {
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			assert.Equal(t, strconv.Itoa(want.projectID), params.Get("projectIdOrKey"))
 			assert.Equal(t, want.keyword, params.Get("keyword"))
@@ -105,7 +110,7 @@ func TestWikiService_Search_param_error(t *testing.T) {
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -125,7 +130,7 @@ func TestWikiService_Search_clientError(t *testing.T) {
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			return nil, errors.New("error")
 		},
 	})
@@ -148,7 +153,7 @@ func TestWikiService_Count(t *testing.T) {
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			assert.Equal(t, want.projectKey, params.Get("projectIdOrKey"))
 
@@ -173,7 +178,7 @@ func TestWikiService_Count_param_error(t *testing.T) {
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -193,7 +198,7 @@ func TestWikiService_Count_clientError(t *testing.T) {
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			return nil, errors.New("error")
 		},
 	})
@@ -212,7 +217,7 @@ func TestWikiService_Count_invaliedJson(t *testing.T) {
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -245,7 +250,7 @@ func TestWikiService_One(t *testing.T) {
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			assert.Nil(t, params)
 
@@ -261,6 +266,22 @@ func TestWikiService_One(t *testing.T) {
 	assert.Equal(t, want.wikiID, wiki.ID)
 	assert.Equal(t, want.name, wiki.Name)
 }
+func TestWikiService_OneContext(t *testing.T) {
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+
+	s := &backlog.WikiService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "value", ctx.Value(ctxKey{}))
+			return nil, errors.New("error")
+		},
+	})
+	wiki, err := s.OneContext(ctx, 1)
+	assert.Error(t, err)
+	assert.Nil(t, wiki)
+}
+
 func TestWikiService_One_param(t *testing.T) {
 	cases := map[string]struct {
 		wikiID    int
@@ -292,7 +313,7 @@ func TestWikiService_One_param(t *testing.T) {
 			s := &backlog.WikiService{}
 			s.ExportSetMethod(&backlog.ExportMethod{
 
-				Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+				Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 					resp := &http.Response{
 						StatusCode: http.StatusOK,
 						Body:       bj,
@@ -315,7 +336,7 @@ func TestWikiService_One_clientError(t *testing.T) {
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			return nil, errors.New("error")
 		},
 	})
@@ -334,7 +355,7 @@ func TestWikiService_One_invaliedJson(t *testing.T) {
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Get: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -374,7 +395,7 @@ func TestWikiService_Create(t *testing.T) {
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Post: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			assert.NotNil(t, params)
 			assert.Equal(t, want.name, params.Get("name"))
@@ -447,7 +468,7 @@ func TestWikiService_Create_param(t *testing.T) {
 			s := &backlog.WikiService{}
 			s.ExportSetMethod(&backlog.ExportMethod{
 
-				Post: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+				Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 					resp := &http.Response{
 						StatusCode: http.StatusOK,
 						Body:       bj,
@@ -469,7 +490,7 @@ func TestWikiService_Create_clientError(t *testing.T) {
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Post: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			return nil, errors.New("error")
 		},
 	})
@@ -487,7 +508,7 @@ func TestWikiService_Create_invaliedJson(t *testing.T) {
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Post: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -510,7 +531,7 @@ func TestWikiService_Create_option_error(t *testing.T) {
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Post: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Post: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -552,7 +573,7 @@ func TestWikiService_Update(t *testing.T) {
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Patch: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			assert.NotNil(t, params)
 			assert.Equal(t, want.name, params.Get("name"))
@@ -625,7 +646,7 @@ func TestWikiService_Update_param(t *testing.T) {
 			s := &backlog.WikiService{}
 			s.ExportSetMethod(&backlog.ExportMethod{
 
-				Patch: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+				Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 					resp := &http.Response{
 						StatusCode: http.StatusOK,
 						Body:       bj,
@@ -647,7 +668,7 @@ func TestWikiService_Update_clientError(t *testing.T) {
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Patch: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			return nil, errors.New("error")
 		},
 	})
@@ -665,7 +686,7 @@ func TestWikiService_Update_invaliedJson(t *testing.T) {
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Patch: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -688,7 +709,7 @@ func TestWikiService_Update_option_required(t *testing.T) {
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Patch: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -721,7 +742,7 @@ func TestWikiService_Delete(t *testing.T) {
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			assert.Equal(t, want.spath, spath)
 			assert.NotNil(t, params)
 			assert.Equal(t, want.mailNotify, params.Get("mailNotify"))
@@ -770,7 +791,7 @@ func TestWikiService_Delete_param(t *testing.T) {
 			s := &backlog.WikiService{}
 			s.ExportSetMethod(&backlog.ExportMethod{
 
-				Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+				Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 					resp := &http.Response{
 						StatusCode: http.StatusOK,
 						Body:       bj,
@@ -792,7 +813,7 @@ func TestWikiService_Delete_clientError(t *testing.T) {
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			return nil, errors.New("error")
 		},
 	})
@@ -810,7 +831,7 @@ func TestWikiService_Delete_invaliedJson(t *testing.T) {
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -833,7 +854,7 @@ func TestWikiService_Delete_option_error(t *testing.T) {
 	s := &backlog.WikiService{}
 	s.ExportSetMethod(&backlog.ExportMethod{
 
-		Delete: func(spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		Delete: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
 			resp := &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       bj,
@@ -848,3 +869,630 @@ func TestWikiService_Delete_option_error(t *testing.T) {
 	assert.Nil(t, wiki)
 	assert.Error(t, err)
 }
+
+func TestWikiTagService_All(t *testing.T) {
+	bj, err := os.Open("testdata/json/wiki_tag_list.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.WikiTagService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "wikis/tags", spath)
+			assert.Equal(t, "TEST", params.Get("projectIdOrKey"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	tags, err := s.All(backlog.ProjectKey("TEST"))
+	assert.NoError(t, err)
+	if assert.Len(t, tags, 2) {
+		assert.Equal(t, 1, tags[0].ID)
+		assert.Equal(t, "manual", tags[1].Name)
+	}
+}
+
+func TestWikiTagService_All_invaliedProject(t *testing.T) {
+	s := &backlog.WikiTagService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Get must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	tags, err := s.All(backlog.ProjectID(0))
+	assert.Nil(t, tags)
+	assert.Error(t, err)
+}
+
+func TestWikiTagService_All_invaliedJson(t *testing.T) {
+	bj, err := os.Open("testdata/json/invalied.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.WikiTagService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+
+	tags, err := s.All(backlog.ProjectKey("TEST"))
+	assert.Nil(t, tags)
+	assert.Error(t, err)
+}
+
+func TestWikiHistoryService_List(t *testing.T) {
+	bj, err := os.Open("testdata/json/wiki_history_list.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.WikiHistoryService{
+		Option: &backlog.WikiHistoryOptionService{},
+	}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "wikis/1/history", spath)
+			assert.Equal(t, "1", params.Get("minId"))
+			assert.Equal(t, "3", params.Get("maxId"))
+			assert.Equal(t, "20", params.Get("count"))
+			assert.Equal(t, "desc", params.Get("order"))
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	o := s.Option
+	histories, err := s.List(1, o.WithMinID(1), o.WithMaxID(3), o.WithCount(20), o.WithOrder(backlog.OrderDesc))
+	assert.NoError(t, err)
+	if assert.Len(t, histories, 3) {
+		assert.Equal(t, 1, histories[0].PageID)
+		assert.Equal(t, 3, histories[0].Version)
+		assert.Equal(t, "Top", histories[2].Name)
+		assert.Equal(t, "admin", histories[2].CreatedUser.Name)
+	}
+}
+
+func TestWikiHistoryService_List_param_error(t *testing.T) {
+	o := &backlog.WikiHistoryOptionService{}
+	cases := map[string]struct {
+		wikiID  int
+		options []backlog.WikiHistoryOption
+	}{
+		"wikiID_0": {
+			wikiID: 0,
+		},
+		"minID_0": {
+			wikiID:  1,
+			options: []backlog.WikiHistoryOption{o.WithMinID(0)},
+		},
+		"maxID_0": {
+			wikiID:  1,
+			options: []backlog.WikiHistoryOption{o.WithMaxID(0)},
+		},
+		"count_0": {
+			wikiID:  1,
+			options: []backlog.WikiHistoryOption{o.WithCount(0)},
+		},
+		"invalied_order": {
+			wikiID:  1,
+			options: []backlog.WikiHistoryOption{o.WithOrder("invalied")},
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			s := &backlog.WikiHistoryService{}
+			s.ExportSetMethod(&backlog.ExportMethod{
+				Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+					t.Error("s.method.Get must never be called")
+					return nil, errors.New("error")
+				},
+			})
+
+			histories, err := s.List(tc.wikiID, tc.options...)
+			assert.Nil(t, histories)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestWikiHistoryService_List_clientError(t *testing.T) {
+	s := &backlog.WikiHistoryService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			return nil, errors.New("error")
+		},
+	})
+
+	histories, err := s.List(1)
+	assert.Nil(t, histories)
+	assert.Error(t, err)
+}
+
+func TestWikiStarService_List(t *testing.T) {
+	bj, err := os.Open("testdata/json/star_list.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.WikiStarService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "wikis/1/stars", spath)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+	stars, err := s.List(1)
+	assert.NoError(t, err)
+	if assert.Len(t, stars, 1) {
+		assert.Equal(t, 75, stars[0].ID)
+		assert.Equal(t, "", stars[0].Comment)
+		assert.Equal(t, "admin", stars[0].Presenter.Name)
+	}
+}
+
+func TestWikiStarService_List_param_error(t *testing.T) {
+	s := &backlog.WikiStarService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Get must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	stars, err := s.List(0)
+	assert.Nil(t, stars)
+	assert.Error(t, err)
+}
+
+func TestWikiStarService_List_invaliedJson(t *testing.T) {
+	bj, err := os.Open("testdata/json/invalied.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.WikiStarService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+
+	stars, err := s.List(1)
+	assert.Nil(t, stars)
+	assert.Error(t, err)
+}
+
+// newWikiHistoryGet returns the mock of the history which has the versions from 1 to latest.
+// It responds versions in descending order by maxId and count like the API, and the items have no ID.
+func newWikiHistoryGet(t *testing.T, latest int, requests *[]url.Values) func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+	return func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		assert.Equal(t, "wikis/1/history", spath)
+		v := *params.ExportURLValues()
+		*requests = append(*requests, v)
+
+		max := latest
+		if maxID, err := strconv.Atoi(v.Get("maxId")); err == nil && maxID < max {
+			max = maxID
+		}
+		count, _ := strconv.Atoi(v.Get("count"))
+
+		items := []string{}
+		for version := max; version >= 1 && len(items) < count; version-- {
+			items = append(items, fmt.Sprintf(
+				`{"pageId":1,"version":%d,"name":"Home","content":"version %d","createdUser":{"id":1},"created":"2013-05-30T09:11:36Z"}`,
+				version, version,
+			))
+		}
+
+		resp := &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader("[" + strings.Join(items, ",") + "]")),
+		}
+		return backlog.ExportNewResponse(resp), nil
+	}
+}
+
+func TestWikiHistoryService_Iterate(t *testing.T) {
+	requests := []url.Values{}
+	s := &backlog.WikiHistoryService{
+		Option: &backlog.WikiHistoryOptionService{},
+	}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: newWikiHistoryGet(t, 5, &requests),
+	})
+
+	versions := []int{}
+	it := s.Iterate(1, s.Option.WithCount(2))
+	for it.Next() {
+		versions = append(versions, it.Value().Version)
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, versions)
+	if assert.Len(t, requests, 3) {
+		assert.Empty(t, requests[0].Get("maxId"))
+		assert.Equal(t, "3", requests[1].Get("maxId"))
+		assert.Equal(t, "1", requests[2].Get("maxId"))
+	}
+}
+
+func TestWikiHistoryService_Iterate_param_error(t *testing.T) {
+	s := &backlog.WikiHistoryService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Get must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	it := s.Iterate(0)
+	assert.False(t, it.Next())
+	assert.Error(t, it.Err())
+}
+
+func TestWikiHistoryService_One(t *testing.T) {
+	cases := map[string]struct {
+		version int
+	}{
+		"latest": {
+			version: 150,
+		},
+		"old": {
+			version: 20,
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			requests := []url.Values{}
+			s := &backlog.WikiHistoryService{}
+			s.ExportSetMethod(&backlog.ExportMethod{
+				Get: newWikiHistoryGet(t, 150, &requests),
+			})
+
+			history, err := s.One(1, tc.version)
+			assert.NoError(t, err)
+			if assert.NotNil(t, history) {
+				assert.Equal(t, tc.version, history.Version)
+				assert.Equal(t, fmt.Sprintf("version %d", tc.version), history.Content)
+			}
+			if assert.Len(t, requests, 1) {
+				assert.Equal(t, strconv.Itoa(tc.version), requests[0].Get("maxId"))
+				assert.Equal(t, "1", requests[0].Get("count"))
+			}
+		})
+	}
+}
+
+func TestWikiHistoryService_One_notFound(t *testing.T) {
+	cases := map[string]struct {
+		latest  int
+		version int
+	}{
+		"newer": {
+			latest:  3,
+			version: 4,
+		},
+		"empty": {
+			latest:  0,
+			version: 1,
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			requests := []url.Values{}
+			s := &backlog.WikiHistoryService{}
+			s.ExportSetMethod(&backlog.ExportMethod{
+				Get: newWikiHistoryGet(t, tc.latest, &requests),
+			})
+
+			history, err := s.One(1, tc.version)
+			assert.Nil(t, history)
+			assert.EqualError(t, err, fmt.Sprintf("version %d of wiki 1 is not found", tc.version))
+			assert.Len(t, requests, 1)
+		})
+	}
+}
+
+func TestWikiHistoryService_One_param_error(t *testing.T) {
+	cases := map[string]struct {
+		wikiID  int
+		version int
+	}{
+		"wikiID_0": {
+			wikiID:  0,
+			version: 1,
+		},
+		"version_0": {
+			wikiID:  1,
+			version: 0,
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			s := &backlog.WikiHistoryService{}
+			s.ExportSetMethod(&backlog.ExportMethod{
+				Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+					t.Error("s.method.Get must never be called")
+					return nil, errors.New("error")
+				},
+			})
+
+			history, err := s.One(tc.wikiID, tc.version)
+			assert.Nil(t, history)
+			assert.Error(t, err)
+		})
+	}
+}
+
+// newWikiVersionGet returns the mock which responds the wiki and the versions in the fixtures.
+// It filters the versions by maxId and count like the API.
+func newWikiVersionGet(t *testing.T) func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+	b, err := ioutil.ReadFile("testdata/json/wiki_history_list.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	history := []json.RawMessage{}
+	if err := json.Unmarshal(b, &history); err != nil {
+		t.Fatal(err)
+	}
+	wiki, err := ioutil.ReadFile("testdata/json/wiki_maximum.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+		body := string(wiki)
+		if spath == "wikis/34/history" {
+			maxID, err := strconv.Atoi(params.Get("maxId"))
+			if err != nil {
+				maxID = math.MaxInt32
+			}
+			count, err := strconv.Atoi(params.Get("count"))
+			if err != nil {
+				count = 20
+			}
+
+			items := []json.RawMessage{}
+			for _, item := range history {
+				v := struct {
+					Version int `json:"version"`
+				}{}
+				json.Unmarshal(item, &v)
+				if v.Version <= maxID && len(items) < count {
+					items = append(items, item)
+				}
+			}
+			b, _ := json.Marshal(items)
+			body = string(b)
+		} else {
+			assert.Equal(t, "wikis/34", spath)
+		}
+
+		resp := &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+		}
+		return backlog.ExportNewResponse(resp), nil
+	}
+}
+
+func TestWikiService_Diff(t *testing.T) {
+	cases := map[string]struct {
+		fromVersion int
+		toVersion   int
+		want        string
+	}{
+		"versions": {
+			fromVersion: 2,
+			toVersion:   3,
+			want: "--- Home (version 2)\n+++ Home (version 3)\n" +
+				"@@ -1,2 +1,3 @@\n # Home\n-Welcome.\n+Welcome to the project.\n+See the manual.\n",
+		},
+		"same_content": {
+			fromVersion: 1,
+			toVersion:   1,
+			want:        "",
+		},
+		"current": {
+			fromVersion: 1,
+			toVersion:   0,
+			want: "--- Top (version 1)\n+++ Maximum Wiki Page (current)\n" +
+				"@@ -1 +1 @@\n-Welcome.\n+This is a muximal wiki page.\n",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			s := &backlog.WikiService{}
+			s.ExportSetMethod(&backlog.ExportMethod{
+				Get: newWikiVersionGet(t),
+			})
+
+			diff, err := s.Diff(34, tc.fromVersion, tc.toVersion)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, diff)
+		})
+	}
+}
+
+func TestWikiService_Diff_param_error(t *testing.T) {
+	cases := map[string]struct {
+		wikiID      int
+		fromVersion int
+		toVersion   int
+		want        string
+	}{
+		"wikiID_0": {
+			wikiID:      0,
+			fromVersion: 1,
+			toVersion:   2,
+			want:        "wikiID must be 1 or more: 0",
+		},
+		"wikiID_0_current": {
+			wikiID:      0,
+			fromVersion: 1,
+			toVersion:   0,
+			want:        "wikiID must be 1 or more: 0",
+		},
+		"fromVersion_0": {
+			wikiID:      34,
+			fromVersion: 0,
+			toVersion:   2,
+			want:        "fromVersion must be 1 or more: 0",
+		},
+		"toVersion_-1": {
+			wikiID:      34,
+			fromVersion: 1,
+			toVersion:   -1,
+			want:        "toVersion must be 0 or more: -1",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			s := &backlog.WikiService{}
+			s.ExportSetMethod(&backlog.ExportMethod{
+				Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+					t.Error("s.method.Get must never be called")
+					return nil, errors.New("error")
+				},
+			})
+
+			diff, err := s.Diff(tc.wikiID, tc.fromVersion, tc.toVersion)
+			assert.Empty(t, diff)
+			assert.IsType(t, &backlog.ClinetError{}, err)
+			assert.EqualError(t, err, tc.want)
+		})
+	}
+}
+
+func TestWikiService_Diff_clientError(t *testing.T) {
+	s := &backlog.WikiService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			return nil, errors.New("error")
+		},
+	})
+
+	diff, err := s.Diff(34, 1, 0)
+	assert.Empty(t, diff)
+	assert.Error(t, err)
+}
+
+func TestWikiService_Restore(t *testing.T) {
+	bj, err := os.Open("testdata/json/wiki_maximum.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bj.Close()
+
+	s := &backlog.WikiService{
+		Option: &backlog.WikiOptionService{},
+	}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: newWikiVersionGet(t),
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			assert.Equal(t, "wikis/34", spath)
+			v := *params.ExportURLValues()
+			assert.Equal(t, []string{"Top"}, v["name"])
+			assert.Equal(t, []string{"Welcome.\n"}, v["content"])
+			assert.Equal(t, []string{"true"}, v["mailNotify"])
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       bj,
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+
+	wiki, err := s.Restore(34, 1, s.Option.WithMailNotify(true))
+	assert.NoError(t, err)
+	assert.NotNil(t, wiki)
+}
+
+func TestWikiService_Restore_emptyContent(t *testing.T) {
+	s := &backlog.WikiService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(`[{"pageId":34,"version":1,"name":"Empty","content":""}]`)),
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			v := *params.ExportURLValues()
+			assert.Equal(t, []string{"Empty"}, v["name"])
+			assert.Equal(t, []string{""}, v["content"])
+			_, ok := v["mailNotify"]
+			assert.False(t, ok)
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"id":34}`)),
+			}
+			return backlog.ExportNewResponse(resp), nil
+		},
+	})
+
+	wiki, err := s.Restore(34, 1)
+	assert.NoError(t, err)
+	assert.NotNil(t, wiki)
+}
+
+func TestWikiService_Restore_clientError(t *testing.T) {
+	s := &backlog.WikiService{}
+	s.ExportSetMethod(&backlog.ExportMethod{
+		Get: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			return nil, errors.New("error")
+		},
+		Patch: func(ctx context.Context, spath string, params *backlog.ExportRequestParams) (*backlog.ExportResponse, error) {
+			t.Error("s.method.Patch must never be called")
+			return nil, errors.New("error")
+		},
+	})
+
+	wiki, err := s.Restore(34, 1)
+	assert.Nil(t, wiki)
+	assert.Error(t, err)
+}